@@ -5,11 +5,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
-	"strconv"
 
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// Booler is implemented by types that can convert themselves to a boolean.
+// The boolean converter calls GonvBool before falling back to
+// driver.Valuer, fmt.Stringer and reflection.
+//
+// Example:
+//
+//	type Status string
+//
+//	func (s Status) GonvBool() (bool, error) { return s == "active", nil }
+//
+//	result := Bool[bool](Status("active")) // returns true
+type Booler interface {
+	GonvBool() (bool, error)
+}
+
 // Bool casts an interface to a bool type, ignoring any conversion errors.
 // It returns the zero value of type E if conversion fails.
 //
@@ -32,7 +46,7 @@ func Bool[E ~bool](o any) E {
 //	result, err := BoolE[bool]("true") // returns true, nil
 //	result, err := BoolE[bool]("invalid") // returns false, error
 func BoolE[E ~bool](o any) (E, error) {
-	return boolE[E](o)
+	return boolE[E](defaultConverter, o)
 }
 
 // BoolS casts an interface to a []bool type, ignoring any conversion errors.
@@ -41,40 +55,51 @@ func BoolE[E ~bool](o any) (E, error) {
 // Example:
 //
 //	result := BoolS[[]bool]([]string{"true", "false", "1", "0"}) // returns []bool{true, false, true, false}
-func BoolS[S ~[]E, E ~bool](o any) S {
-	v, _ := BoolSE[S](o)
+func BoolS[S ~[]E, E ~bool](o any, opts ...Option) S {
+	v, _ := BoolSE[S](o, opts...)
 	return v
 }
 
 // BoolSE casts an interface to a []bool type, returning both the converted slice and any error encountered.
 // This function is useful when you need to handle conversion errors for slice data explicitly.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := BoolSE[[]bool]([]string{"true", "false"}) // returns []bool{true, false}, nil
-//	result, err := BoolSE[[]bool]([]string{"true", "invalid"}) // returns []bool{true, false}, error
-func BoolSE[S ~[]E, E ~bool](o any) (S, error) {
-	return toSliceE[S](o, boolE[E])
+//	result, err := BoolSE[[]bool]([]string{"true", "invalid"}) // returns nil, error
+//	result, err := BoolSE[[]bool]([]string{"true", "invalid", "0"}, WithInvalidElements(InvalidElementsSkip))
+//	// returns []bool{true, false}, error
+//	result, err := BoolSE[[]bool]([]string{"true", "invalid", "0"}, WithInvalidElements(InvalidElementsReplace))
+//	// returns []bool{true, false, false}, error
+func BoolSE[S ~[]E, E ~bool](o any, opts ...Option) (S, error) {
+	c := converterWith(opts)
+	return toSliceE[S](c, o, bind(c, boolE[E]))
 }
 
 // boolE is the core implementation of boolean conversion with error handling.
 // It uses a fast path approach for common types and falls back to reflection for complex types.
-func boolE[E ~bool](o any) (E, error) {
+func boolE[E ~bool](c *Converter, o any) (E, error) {
 	// Handle nil input by returning the zero value of type E
 	if o == nil {
 		var zero E
 		return zero, nil
 	}
 
+	// Registered conversions take precedence over the built-in ones
+	if r, ok, err := registered[E](o); ok {
+		return r, err
+	}
+
 	// Fast path: direct type assertions for common types
 	switch b := o.(type) {
 	// Native boolean type
 	case bool:
 		return E(b), nil
 
-	// String conversion using strconv.ParseBool
+	// String conversion using the boolean vocabulary of c
 	case string:
-		v, err := strconv.ParseBool(b)
+		v, err := c.parseBool(b)
 		if err != nil {
 			return failedCastErrValue[E](b, err)
 		}
@@ -82,7 +107,7 @@ func boolE[E ~bool](o any) (E, error) {
 
 	// Byte slice conversion by converting to string first
 	case []byte:
-		v, err := strconv.ParseBool(string(b))
+		v, err := c.parseBool(string(b))
 		if err != nil {
 			return failedCastErrValue[E](b, err)
 		}
@@ -92,19 +117,20 @@ func boolE[E ~bool](o any) (E, error) {
 	case *wrapperspb.BoolValue:
 		return E(b.GetValue()), nil
 	case *wrapperspb.StringValue:
-		v, err := strconv.ParseBool(b.GetValue())
+		v, err := c.parseBool(b.GetValue())
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
 		return E(v), err
 	case *wrapperspb.BytesValue:
-		v, err := strconv.ParseBool(string(b.GetValue()))
+		v, err := c.parseBool(string(b.GetValue()))
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
 		return E(v), err
 
-	// Numeric types: convert to float64 first, then check if non-zero
+	// Numeric types: convert to float64 first, applying the NaN and infinity policy, then check if non-zero;
+	// rejected by strict converters
 	case
 		float64, float32,
 		int, int64, int32, int16, int8,
@@ -113,20 +139,32 @@ func boolE[E ~bool](o any) (E, error) {
 		*wrapperspb.DoubleValue, *wrapperspb.FloatValue,
 		*wrapperspb.Int64Value, *wrapperspb.Int32Value,
 		*wrapperspb.UInt64Value, *wrapperspb.UInt32Value:
-		n, err := FloatE[float64](o)
+		if c.strict {
+			return failedCastValue[E](o)
+		}
+		n, err := floatE[float64](c, o)
 		if err != nil {
-			return failedCastErrValue[E](o, err)
+			var zero E
+			return zero, retarget(err, o, typeOf[E]())
 		}
 		// Non-zero numeric values are treated as true, zero as false
 		return n != 0, nil
 
+	// Booler interface support for types that convert themselves to booleans
+	case Booler:
+		v, err := b.GonvBool()
+		if err != nil {
+			return failedCastErrValue[E](o, err)
+		}
+		return E(v), nil
+
 	// Database driver.Valuer interface support
 	case driver.Valuer:
 		v, err := b.Value()
 		if err != nil {
 			return failedCastErrValue[E](b, err)
 		}
-		r, err := boolE[E](v)
+		r, err := boolE[E](c, v)
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
@@ -134,7 +172,7 @@ func boolE[E ~bool](o any) (E, error) {
 
 	// Stringer interface support for custom types that can be represented as strings
 	case fmt.Stringer:
-		v, err := strconv.ParseBool(b.String())
+		v, err := c.parseBool(b.String())
 		if err != nil {
 			return failedCastErrValue[E](b, err)
 		}
@@ -143,13 +181,13 @@ func boolE[E ~bool](o any) (E, error) {
 	// Default case: use reflection-based conversion for complex types
 	default:
 		// slow path
-		return boolVE[E](o)
+		return boolVE[E](c, o)
 	}
 }
 
 // boolVE is the reflection-based (slow path) implementation for boolean conversion.
 // It's used when fast path type assertions fail and more complex type analysis is needed.
-func boolVE[E ~bool](o any) (E, error) {
+func boolVE[E ~bool](c *Converter, o any) (E, error) {
 	// Get the underlying value, dereferencing pointers if necessary
 	v := indirectValue(reflect.ValueOf(o))
 
@@ -159,19 +197,34 @@ func boolVE[E ~bool](o any) (E, error) {
 	case reflect.Bool:
 		return E(v.Bool()), nil
 
-	// Integer types: non-zero values are true, zero is false
+	// Integer types: non-zero values are true, zero is false; rejected by strict converters
 	case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		return v.Int() != 0, nil
 	case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		return v.Uint() != 0, nil
 
-	// Floating point types: non-zero values are true, zero is false
+	// Floating point types: non-zero values are true, zero is false, NaN and infinities follow the policy of c;
+	// rejected by strict converters
 	case reflect.Float64, reflect.Float32:
-		return v.Float() != 0, nil
+		if c.strict {
+			return failedCastValue[E](o)
+		}
+		n, err := floatFromFloat[float64](c, o, v.Float())
+		if err != nil {
+			var zero E
+			return zero, retarget(err, o, typeOf[E]())
+		}
+		return n != 0, nil
 
-	// String conversion using strconv.ParseBool
+	// String conversion using the boolean vocabulary of c
 	case reflect.String:
-		b, err := strconv.ParseBool(v.String())
+		b, err := c.parseBool(v.String())
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
@@ -183,12 +236,16 @@ func boolVE[E ~bool](o any) (E, error) {
 		if v.Type().Elem().Kind() != reflect.Uint8 {
 			return failedCastValue[E](o)
 		}
-		b, err := strconv.ParseBool(string(v.Bytes()))
+		b, err := c.parseBool(string(v.Bytes()))
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
 		return E(b), err
 
+	// Nil pointers that cannot be dereferenced
+	case reflect.Pointer:
+		return failedCastErrValue[E](o, ErrNil)
+
 	// Unsupported types
 	default:
 		return failedCastValue[E](o)
@@ -1,6 +1,11 @@
 package gonv
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
 
 func TestBoolBasic(t *testing.T) {
 	var b bool
@@ -30,4 +35,27 @@ func TestBoolE_Error(t *testing.T) {
 	if err == nil {
 		t.Fatalf("expected error for invalid bool string")
 	}
+
+	_, err = BoolE[bool](math.NaN())
+	if !errors.Is(err, ErrNonFinite) || strings.Count(err.Error(), "failed to cast") != 1 || !strings.Contains(err.Error(), "to bool") {
+		t.Fatalf("BoolE(NaN) = %v", err)
+	}
+}
+
+type testFlag string
+
+func (f testFlag) GonvBool() (bool, error) {
+	if f == "" {
+		return false, errors.New("empty flag")
+	}
+	return f == "on", nil
+}
+
+func TestBoolE_Booler(t *testing.T) {
+	if v, err := BoolE[bool](testFlag("on")); err != nil || !v {
+		t.Fatalf("BoolE(Booler) = %v, %v", v, err)
+	}
+	if _, err := BoolE[bool](testFlag("")); err == nil {
+		t.Fatalf("expected error from GonvBool to be returned")
+	}
 }
@@ -0,0 +1,715 @@
+package gonv
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Converter performs conversions under a set of policies chosen when it is created.
+// The package-level functions use a default Converter; create your own with New
+// when a conversion needs different policies, so that two parts of one program
+// can convert the same input differently without sharing global state.
+// A Converter is immutable and safe for concurrent use.
+//
+// Example:
+//
+//	c := New(WithRounding(RoundExact))
+//	result, err := c.IntE(3.14) // returns 0, error (fractional part would be lost)
+//	result, err := c.IntE(3.0)  // returns 3, nil
+type Converter struct {
+	rounding    Rounding
+	rounded     bool
+	nonFinite   NonFinite
+	overflow    Overflow
+	invalid     InvalidElements
+	fallback    any
+	base        int
+	strict      bool
+	trueWords   []string
+	falseWords  []string
+	location    *time.Location
+	timeFormats []string
+	timeFormat  string
+	pairSeps    []string
+	keyValSeps  []string
+	sliceSeps   []string
+	noTrim      bool
+	keepEmpty   bool
+	escape      rune
+	required    bool
+	skipped     *[]string
+}
+
+// Option configures a Converter created by New.
+type Option func(c *Converter)
+
+// defaultConverter is the Converter used by the package-level functions.
+var defaultConverter = New()
+
+// converterWith returns the Converter for the options passed to a package-level function:
+// defaultConverter if there are none, or a new Converter with opts applied otherwise.
+func converterWith(opts []Option) *Converter {
+	if len(opts) == 0 {
+		return defaultConverter
+	}
+	return New(opts...)
+}
+
+// lenient reports whether c keeps converting a slice, map or struct past elements that fail to convert.
+func (c *Converter) lenient() bool {
+	return c.invalid == InvalidElementsSkip || c.invalid == InvalidElementsReplace
+}
+
+// partial reports whether err accompanies a partial result that c produced by skipping or replacing
+// elements that failed to convert, so that the result is usable despite err.
+func (c *Converter) partial(err error) bool {
+	_, ok := err.(*ElementsError)
+	return ok && c.lenient()
+}
+
+// replacement returns the value that replaces an element that failed to convert with to under InvalidElementsReplace.
+func replacement[E any](c *Converter, to func(o any) (E, error)) E {
+	if c.fallback != nil {
+		if v, err := to(c.fallback); err == nil {
+			return v
+		}
+	}
+	var zero E
+	return zero
+}
+
+// bind returns a function converting values with conv under the policies of c,
+// for use as the element converter of a slice or map conversion.
+func bind[E any](c *Converter, conv func(c *Converter, o any) (E, error)) func(o any) (E, error) {
+	return func(o any) (E, error) {
+		return conv(c, o)
+	}
+}
+
+// New creates a Converter with the given options applied on top of the default policies.
+//
+// Example:
+//
+//	c := New(WithRounding(RoundExact))
+func New(opts ...Option) *Converter {
+	c := &Converter{
+		rounding:   RoundTruncate,
+		nonFinite:  NonFiniteReject,
+		overflow:   OverflowReject,
+		base:       0,
+		location:   time.UTC,
+		pairSeps:   []string{",", ";"},
+		keyValSeps: []string{"=", ":"},
+		sliceSeps:  []string{",", ";", " ", "\t", "\n", "\r"},
+		escape:     '\\',
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Rounding selects how floating-point values are converted to integers.
+type Rounding int
+
+const (
+	// RoundTruncate discards the fractional part, rounding toward zero. It is the default.
+	RoundTruncate Rounding = iota
+	// RoundExact rejects values with a non-zero fractional part with an error wrapping ErrPrecision.
+	RoundExact
+	// RoundHalfEven rounds to the nearest integer, rounding ties to the even integer.
+	RoundHalfEven
+	// RoundHalfAwayFromZero rounds to the nearest integer, rounding ties away from zero.
+	RoundHalfAwayFromZero
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeil rounds toward positive infinity.
+	RoundCeil
+)
+
+// WithRounding sets how floating-point values are converted to integers.
+//
+// The policy applies to floating-point inputs, float-looking strings such as "2.5",
+// json.Number and the protobuf Double and Float wrappers.
+// Strings and json.Number values with a fractional part are only rounded once a policy is set with this option;
+// otherwise they are rejected as invalid syntax, so that "19.99" does not silently become 19.
+//
+// Example:
+//
+//	c := New(WithRounding(RoundHalfEven))
+//	result := c.Int("2.5") // returns 2
+func WithRounding(r Rounding) Option {
+	return func(c *Converter) {
+		c.rounding = r
+		c.rounded = true
+	}
+}
+
+// round applies the rounding policy of c to v.
+// It reports false if the policy rejects the loss of the fractional part of v.
+//
+// Example:
+//
+//	New(WithRounding(RoundHalfEven)).round(2.5) // returns 2, true
+//	New(WithRounding(RoundExact)).round(2.5)    // returns 2, false
+func (c *Converter) round(v float64) (float64, bool) {
+	switch c.rounding {
+	case RoundExact:
+		t := math.Trunc(v)
+		return t, t == v
+	case RoundHalfEven:
+		return math.RoundToEven(v), true
+	case RoundHalfAwayFromZero:
+		return math.Round(v), true
+	case RoundFloor:
+		return math.Floor(v), true
+	case RoundCeil:
+		return math.Ceil(v), true
+	default:
+		return math.Trunc(v), true
+	}
+}
+
+// NonFinite selects how NaN and infinite floating-point values are converted.
+// The policy is applied the same way by the integer, floating-point, duration and boolean converters.
+type NonFinite int
+
+const (
+	// NonFiniteReject rejects NaN and infinities with an error wrapping ErrNonFinite. It is the default.
+	NonFiniteReject NonFinite = iota
+	// NonFiniteZero converts NaN and infinities to the zero value of the target type.
+	NonFiniteZero
+	// NonFiniteSaturate converts +Inf and -Inf to the largest and smallest value of the target type,
+	// and NaN to zero.
+	NonFiniteSaturate
+)
+
+// WithNonFinite sets how NaN and infinite floating-point values are converted.
+//
+// Example:
+//
+//	c := New(WithNonFinite(NonFiniteSaturate))
+//	result := c.Int8(math.Inf(1)) // returns 127
+//	result := c.Float32("-Inf")   // returns -math.MaxFloat32
+func WithNonFinite(p NonFinite) Option {
+	return func(c *Converter) {
+		c.nonFinite = p
+	}
+}
+
+// nonFiniteSign applies the NaN and infinity policy of c to the non-finite value v.
+// It returns -1, 0 or 1 to select the smallest, zero or largest value of the target type,
+// and reports false if the policy rejects v.
+func (c *Converter) nonFiniteSign(v float64) (int, bool) {
+	switch c.nonFinite {
+	case NonFiniteZero:
+		return 0, true
+	case NonFiniteSaturate:
+		switch {
+		case math.IsInf(v, 1):
+			return 1, true
+		case math.IsInf(v, -1):
+			return -1, true
+		default:
+			return 0, true
+		}
+	default:
+		return 0, false
+	}
+}
+
+// Overflow selects how integer, duration and floating-point conversions handle values outside the range of the target type.
+// Floating-point values are never wrapped: OverflowWrap rejects them as OverflowReject does.
+type Overflow int
+
+const (
+	// OverflowReject rejects out-of-range values with an error wrapping ErrOverflow,
+	// and negative values converted to unsigned types with an error wrapping ErrNegative. It is the default.
+	OverflowReject Overflow = iota
+	// OverflowWrap keeps the low bits of out-of-range values, wrapping around like a Go conversion.
+	// Integer strings and integral floats beyond 64 bits wrap alike.
+	// Negative values converted to unsigned types are still rejected.
+	OverflowWrap
+	// OverflowSaturate clamps out-of-range values to the smallest or largest value of the target type,
+	// and negative values converted to unsigned types to zero.
+	OverflowSaturate
+)
+
+// InvalidElements selects how slice, map and struct conversions handle elements and fields that fail to convert.
+type InvalidElements int
+
+const (
+	// InvalidElementsReject stops at the first element that fails to convert and returns its error. It is the default.
+	InvalidElementsReject InvalidElements = iota
+	// InvalidElementsCollect converts every element and returns an *ElementsError
+	// listing all elements that failed to convert, with their index or key.
+	InvalidElementsCollect
+	// InvalidElementsSkip drops the elements that fail to convert and returns the remaining ones,
+	// together with an *ElementsError listing the dropped elements.
+	InvalidElementsSkip
+	// InvalidElementsReplace replaces the elements that fail to convert with the zero value of the element type,
+	// or the value set with WithElementDefault, and returns them together with an *ElementsError
+	// listing the replaced elements. Map entries whose key fails to convert are dropped.
+	InvalidElementsReplace
+)
+
+// WithInvalidElements sets how slice, map and struct conversions handle elements and fields that fail to convert.
+// Slice and map functions such as IntSliceE and StringIntMapE accept options for a single call.
+//
+// Example:
+//
+//	_, err := IntSliceE[[]int]([]string{"1", "x", "y"}, WithInvalidElements(InvalidElementsCollect))
+//	// err lists the failures at [1] and [2]
+func WithInvalidElements(p InvalidElements) Option {
+	return func(c *Converter) {
+		c.invalid = p
+	}
+}
+
+// WithElementDefault sets the value that replaces elements that fail to convert under InvalidElementsReplace,
+// and selects InvalidElementsReplace. The value is converted to the element type like any element;
+// if that fails, the zero value of the element type is used instead.
+//
+// Example:
+//
+//	result, err := IntSliceE[[]int]([]string{"1", "x"}, WithElementDefault(-1)) // returns []int{1, -1}, error
+func WithElementDefault(v any) Option {
+	return func(c *Converter) {
+		c.invalid = InvalidElementsReplace
+		c.fallback = v
+	}
+}
+
+// WithOverflow sets how integer and duration conversions handle values outside the range of the target type.
+//
+// Example:
+//
+//	c := New(WithOverflow(OverflowSaturate))
+//	result := c.Int16(100000) // returns math.MaxInt16
+//	result := c.Uint8(-5)     // returns 0
+func WithOverflow(p Overflow) Option {
+	return func(c *Converter) {
+		c.overflow = p
+	}
+}
+
+// WithBase sets the base used to parse integer strings, as accepted by strconv.ParseInt.
+// The default base 0 infers the base from the prefix of the string ("0x", "0o", "0b" or "0").
+//
+// Example:
+//
+//	c := New(WithBase(10))
+//	result := c.Int("010") // returns 10 instead of 8
+func WithBase(base int) Option {
+	return func(c *Converter) {
+		c.base = base
+	}
+}
+
+// WithStrict rejects the implicit conversions between booleans and numbers,
+// such as true to 1 or 0 to false, with an error wrapping ErrUnsupported.
+//
+// Example:
+//
+//	c := New(WithStrict())
+//	result, err := c.IntE(true) // returns 0, error
+func WithStrict() Option {
+	return func(c *Converter) {
+		c.strict = true
+	}
+}
+
+// WithRequiredFields makes Copy return a *CopyError if a field of the destination has no matching field in the source.
+// Without this option such fields are left as they are and only reported alongside failed fields.
+//
+// Example:
+//
+//	err := Copy(&user, dto, WithRequiredFields()) // returns a *CopyError listing the fields missing from dto
+func WithRequiredFields() Option {
+	return func(c *Converter) {
+		c.required = true
+	}
+}
+
+// WithSkippedFields makes Copy store the names of the fields of the destination that have no matching field
+// in the source in *skipped, replacing its contents, whether or not the copy fails.
+// It is meant to be passed to a single Copy call: a Converter created with it writes to *skipped on every copy.
+//
+// Example:
+//
+//	var skipped []string
+//	err := Copy(&user, dto, WithSkippedFields(&skipped)) // returns nil, skipped = []string{"CreatedBy"}
+func WithSkippedFields(skipped *[]string) Option {
+	return func(c *Converter) {
+		c.skipped = skipped
+	}
+}
+
+// WithBoolStrings sets the words accepted as true and false when parsing boolean strings.
+// Words are matched case-insensitively. Without this option strconv.ParseBool is used.
+//
+// Example:
+//
+//	c := New(WithBoolStrings([]string{"yes", "on", "1"}, []string{"no", "off", "0"}))
+//	result := c.Bool("Yes") // returns true
+func WithBoolStrings(trueWords, falseWords []string) Option {
+	return func(c *Converter) {
+		c.trueWords = append([]string(nil), trueWords...)
+		c.falseWords = append([]string(nil), falseWords...)
+	}
+}
+
+// WithLocation sets the location used by the Time methods to interpret strings without a timezone.
+// The default is time.UTC.
+//
+// Example:
+//
+//	loc, _ := time.LoadLocation("America/New_York")
+//	c := New(WithLocation(loc))
+func WithLocation(loc *time.Location) Option {
+	return func(c *Converter) {
+		c.location = loc
+	}
+}
+
+// WithTimeFormats sets the layouts tried, in order, when parsing time strings.
+// The default is the time format registry, see RegisterTimeFormats and SetTimeFormats.
+//
+// Example:
+//
+//	c := New(WithTimeFormats("02/01/2006", time.RFC3339))
+func WithTimeFormats(layouts ...string) Option {
+	return func(c *Converter) {
+		c.timeFormats = append([]string(nil), layouts...)
+	}
+}
+
+// WithTimeFormat sets the layout used to format times as strings.
+// The default is the package-level DefaultTimeFormat.
+//
+// Example:
+//
+//	c := New(WithTimeFormat(time.DateOnly))
+//	result := c.String(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)) // returns "2023-01-02"
+func WithTimeFormat(layout string) Option {
+	return func(c *Converter) {
+		c.timeFormat = layout
+	}
+}
+
+// WithPairSeparators sets the separators between the pairs of delimited map strings such as "env=prod,team=core".
+// Map conversions parse strings that are not JSON objects as delimited pairs.
+// The default separators are "," and ";".
+//
+// Example:
+//
+//	result := StringStringMap[string, string]("env=prod&team=core", WithPairSeparators("&"))
+//	// returns map[string]string{"env": "prod", "team": "core"}
+func WithPairSeparators(seps ...string) Option {
+	return func(c *Converter) {
+		c.pairSeps = append([]string(nil), seps...)
+	}
+}
+
+// WithKeyValueSeparators sets the separators between the key and the value of each pair of delimited map strings.
+// A pair is split at the first separator it contains. The default separators are "=" and ":".
+//
+// Example:
+//
+//	result := StringIntMap[string, int]("a->1,b->2", WithKeyValueSeparators("->")) // returns map[string]int{"a": 1, "b": 2}
+func WithKeyValueSeparators(seps ...string) Option {
+	return func(c *Converter) {
+		c.keyValSeps = append([]string(nil), seps...)
+	}
+}
+
+// WithTrimSpace sets whether white space around the keys and values of delimited map strings
+// and the elements of delimited slice strings is removed. It is removed by default.
+//
+// Example:
+//
+//	result := StringStringMap[string, string]("a = 1", WithTrimSpace(false)) // returns map[string]string{"a ": " 1"}
+func WithTrimSpace(trim bool) Option {
+	return func(c *Converter) {
+		c.noTrim = !trim
+	}
+}
+
+// WithSliceSeparators sets the separators between the elements of delimited slice strings such as "1, 2, 3".
+// Slice conversions split string and []byte inputs into elements and convert each of them.
+// The default separators are ",", ";" and white space, so that "1,2", "1; 2" and "1 2" all hold two elements.
+//
+// Example:
+//
+//	result := StringSlice[[]string]("a b\nc d", WithSliceSeparators("\n")) // returns []string{"a b", "c d"}
+func WithSliceSeparators(seps ...string) Option {
+	return func(c *Converter) {
+		c.sliceSeps = append([]string(nil), seps...)
+	}
+}
+
+// WithKeepEmpty keeps the empty elements of delimited slice strings, which are dropped by default,
+// so that "1,,3" holds three elements and fails to convert to []int instead of holding two.
+//
+// Example:
+//
+//	result := StringSlice[[]string]("a,,b", WithKeepEmpty(), WithSliceSeparators(",")) // returns []string{"a", "", "b"}
+func WithKeepEmpty() Option {
+	return func(c *Converter) {
+		c.keepEmpty = true
+	}
+}
+
+// WithEscape sets the character that escapes separators in delimited strings,
+// so that they can appear in keys and values. The escape character escapes itself.
+// The default is the backslash; 0 disables escaping.
+//
+// Example:
+//
+//	result := StringStringMap[string, string](`path=a\,b,mode=ro`) // returns map[string]string{"path": "a,b", "mode": "ro"}
+func WithEscape(r rune) Option {
+	return func(c *Converter) {
+		c.escape = r
+	}
+}
+
+// parseBool parses s as a boolean using the vocabulary of c, or strconv.ParseBool if c has none.
+func (c *Converter) parseBool(s string) (bool, error) {
+	if c.trueWords == nil && c.falseWords == nil {
+		return strconv.ParseBool(s)
+	}
+	for _, w := range c.trueWords {
+		if strings.EqualFold(s, w) {
+			return true, nil
+		}
+	}
+	for _, w := range c.falseWords {
+		if strings.EqualFold(s, w) {
+			return false, nil
+		}
+	}
+	return false, &strconv.NumError{Func: "ParseBool", Num: s, Err: strconv.ErrSyntax}
+}
+
+// layouts returns the layouts c tries when parsing time strings.
+func (c *Converter) layouts() []string {
+	if c.timeFormats != nil {
+		return c.timeFormats
+	}
+	return loadTimeFormats()
+}
+
+// layout returns the layout c uses to format times as strings.
+func (c *Converter) layout() string {
+	if c.timeFormat != "" {
+		return c.timeFormat
+	}
+	return DefaultTimeFormat
+}
+
+// Int converts o to an int under the policies of c, ignoring any conversion errors.
+func (c *Converter) Int(o any) int {
+	v, _ := c.IntE(o)
+	return v
+}
+
+// IntE converts o to an int under the policies of c.
+func (c *Converter) IntE(o any) (int, error) {
+	return intE[int](c, o)
+}
+
+// Int8 converts o to an int8 under the policies of c, ignoring any conversion errors.
+func (c *Converter) Int8(o any) int8 {
+	v, _ := c.Int8E(o)
+	return v
+}
+
+// Int8E converts o to an int8 under the policies of c.
+func (c *Converter) Int8E(o any) (int8, error) {
+	return intE[int8](c, o)
+}
+
+// Int16 converts o to an int16 under the policies of c, ignoring any conversion errors.
+func (c *Converter) Int16(o any) int16 {
+	v, _ := c.Int16E(o)
+	return v
+}
+
+// Int16E converts o to an int16 under the policies of c.
+func (c *Converter) Int16E(o any) (int16, error) {
+	return intE[int16](c, o)
+}
+
+// Int32 converts o to an int32 under the policies of c, ignoring any conversion errors.
+func (c *Converter) Int32(o any) int32 {
+	v, _ := c.Int32E(o)
+	return v
+}
+
+// Int32E converts o to an int32 under the policies of c.
+func (c *Converter) Int32E(o any) (int32, error) {
+	return intE[int32](c, o)
+}
+
+// Int64 converts o to an int64 under the policies of c, ignoring any conversion errors.
+func (c *Converter) Int64(o any) int64 {
+	v, _ := c.Int64E(o)
+	return v
+}
+
+// Int64E converts o to an int64 under the policies of c.
+func (c *Converter) Int64E(o any) (int64, error) {
+	return intE[int64](c, o)
+}
+
+// Uint converts o to a uint under the policies of c, ignoring any conversion errors.
+func (c *Converter) Uint(o any) uint {
+	v, _ := c.UintE(o)
+	return v
+}
+
+// UintE converts o to a uint under the policies of c.
+func (c *Converter) UintE(o any) (uint, error) {
+	return uintE[uint](c, o)
+}
+
+// Uint8 converts o to a uint8 under the policies of c, ignoring any conversion errors.
+func (c *Converter) Uint8(o any) uint8 {
+	v, _ := c.Uint8E(o)
+	return v
+}
+
+// Uint8E converts o to a uint8 under the policies of c.
+func (c *Converter) Uint8E(o any) (uint8, error) {
+	return uintE[uint8](c, o)
+}
+
+// Uint16 converts o to a uint16 under the policies of c, ignoring any conversion errors.
+func (c *Converter) Uint16(o any) uint16 {
+	v, _ := c.Uint16E(o)
+	return v
+}
+
+// Uint16E converts o to a uint16 under the policies of c.
+func (c *Converter) Uint16E(o any) (uint16, error) {
+	return uintE[uint16](c, o)
+}
+
+// Uint32 converts o to a uint32 under the policies of c, ignoring any conversion errors.
+func (c *Converter) Uint32(o any) uint32 {
+	v, _ := c.Uint32E(o)
+	return v
+}
+
+// Uint32E converts o to a uint32 under the policies of c.
+func (c *Converter) Uint32E(o any) (uint32, error) {
+	return uintE[uint32](c, o)
+}
+
+// Uint64 converts o to a uint64 under the policies of c, ignoring any conversion errors.
+func (c *Converter) Uint64(o any) uint64 {
+	v, _ := c.Uint64E(o)
+	return v
+}
+
+// Uint64E converts o to a uint64 under the policies of c.
+func (c *Converter) Uint64E(o any) (uint64, error) {
+	return uintE[uint64](c, o)
+}
+
+// Float32 converts o to a float32 under the policies of c, ignoring any conversion errors.
+func (c *Converter) Float32(o any) float32 {
+	v, _ := c.Float32E(o)
+	return v
+}
+
+// Float32E converts o to a float32 under the policies of c.
+func (c *Converter) Float32E(o any) (float32, error) {
+	return floatE[float32](c, o)
+}
+
+// Float64 converts o to a float64 under the policies of c, ignoring any conversion errors.
+func (c *Converter) Float64(o any) float64 {
+	v, _ := c.Float64E(o)
+	return v
+}
+
+// Float64E converts o to a float64 under the policies of c.
+func (c *Converter) Float64E(o any) (float64, error) {
+	return floatE[float64](c, o)
+}
+
+// Bool converts o to a bool under the policies of c, ignoring any conversion errors.
+func (c *Converter) Bool(o any) bool {
+	v, _ := c.BoolE(o)
+	return v
+}
+
+// BoolE converts o to a bool under the policies of c.
+func (c *Converter) BoolE(o any) (bool, error) {
+	return boolE[bool](c, o)
+}
+
+// Duration converts o to a time.Duration under the policies of c, ignoring any conversion errors.
+func (c *Converter) Duration(o any) time.Duration {
+	v, _ := c.DurationE(o)
+	return v
+}
+
+// DurationE converts o to a time.Duration under the policies of c.
+func (c *Converter) DurationE(o any) (time.Duration, error) {
+	return durationE(c, o)
+}
+
+// String converts o to a string under the policies of c, ignoring any conversion errors.
+func (c *Converter) String(o any) string {
+	v, _ := c.StringE(o)
+	return v
+}
+
+// StringE converts o to a string under the policies of c.
+func (c *Converter) StringE(o any) (string, error) {
+	return stringE[string](c, o)
+}
+
+// Time converts o to a time.Time in the location of c, ignoring any conversion errors.
+func (c *Converter) Time(o any) time.Time {
+	v, _ := c.TimeE(o)
+	return v
+}
+
+// TimeE converts o to a time.Time in the location of c.
+func (c *Converter) TimeE(o any) (time.Time, error) {
+	return timeInLocationE(c, o, c.location)
+}
+
+// TimeInLocation converts o to a time.Time, interpreting inputs without a timezone
+// to be in the given location, ignoring any conversion errors.
+func (c *Converter) TimeInLocation(o any, location *time.Location) time.Time {
+	v, _ := c.TimeInLocationE(o, location)
+	return v
+}
+
+// TimeInLocationE converts o to a time.Time, interpreting inputs without a timezone
+// to be in the given location.
+func (c *Converter) TimeInLocationE(o any, location *time.Location) (time.Time, error) {
+	return timeInLocationE(c, o, location)
+}
+
+// ConvertToType converts o to the type t, as ConvertToType does with the options of c.
+func (c *Converter) ConvertToType(o any, t reflect.Type) (reflect.Value, error) {
+	return convertTo(c, o, t)
+}
+
+// ConvertInto converts src to the type dst points to, as ConvertInto does with the options of c.
+func (c *Converter) ConvertInto(dst any, src any) error {
+	return convertInto(c, dst, src)
+}
+
+// Copy copies the fields of src into dst, as Copy does with the options of c.
+func (c *Converter) Copy(dst, src any) error {
+	return copyStruct(c, dst, src)
+}
@@ -0,0 +1,325 @@
+package gonv
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestConverter_RoundExact(t *testing.T) {
+	c := New(WithRounding(RoundExact))
+	for _, in := range []any{3.14, float32(0.5), wrapperspb.Double(-2.5), namedFloat(1.25)} {
+		if _, err := c.IntE(in); !errors.Is(err, ErrPrecision) {
+			t.Fatalf("IntE(%v): expected ErrPrecision, got %v", in, err)
+		}
+		if _, err := c.Uint64E(in); err == nil {
+			t.Fatalf("Uint64E(%v): expected error", in)
+		}
+	}
+	if v, err := c.IntE(3.0); err != nil || v != 3 {
+		t.Fatalf("IntE(3.0) = %v, %v", v, err)
+	}
+	if v, err := IntE[int](3.14); err != nil || v != 3 {
+		t.Fatalf("default IntE(3.14) = %v, %v", v, err)
+	}
+}
+
+type namedFloat float64
+
+func TestConverter_Rounding(t *testing.T) {
+	tests := []struct {
+		rounding Rounding
+		input    any
+		want     int
+	}{
+		{RoundTruncate, 2.7, 2},
+		{RoundTruncate, "-2.7", -2},
+		{RoundHalfEven, 2.5, 2},
+		{RoundHalfEven, "3.5", 4},
+		{RoundHalfEven, json.Number("-2.5"), -2},
+		{RoundHalfAwayFromZero, 2.5, 3},
+		{RoundHalfAwayFromZero, wrapperspb.Float(-2.5), -3},
+		{RoundFloor, "-2.1", -3},
+		{RoundFloor, []byte("2.9"), 2},
+		{RoundCeil, 2.1, 3},
+		{RoundCeil, wrapperspb.String("-2.9"), -2},
+	}
+	for _, tt := range tests {
+		got, err := New(WithRounding(tt.rounding)).IntE(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("rounding %d: IntE(%v) = %v, %v, want %v", tt.rounding, tt.input, got, err, tt.want)
+		}
+	}
+
+	if got, err := New(WithRounding(RoundHalfEven)).Uint8E("254.5"); err != nil || got != 254 {
+		t.Errorf("Uint8E(254.5) = %v, %v", got, err)
+	}
+	if _, err := New(WithRounding(RoundHalfAwayFromZero)).Uint8E("255.5"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Uint8E(255.5): expected ErrOverflow, got %v", err)
+	}
+	for _, in := range []any{"19.99", json.Number("2.5"), []byte("0.5")} {
+		if _, err := IntE[int](in); !errors.Is(err, ErrSyntax) {
+			t.Errorf("default IntE(%v): expected ErrSyntax, got %v", in, err)
+		}
+		if _, err := UintE[uint](in); !errors.Is(err, ErrSyntax) {
+			t.Errorf("default UintE(%v): expected ErrSyntax, got %v", in, err)
+		}
+	}
+	if got, err := IntE[int]("1e3"); err != nil || got != 1000 {
+		t.Errorf("default IntE(\"1e3\") = %v, %v", got, err)
+	}
+	if got, err := New(WithRounding(RoundTruncate)).IntE("19.99"); err != nil || got != 19 {
+		t.Errorf("truncate IntE(\"19.99\") = %v, %v", got, err)
+	}
+	if _, err := New(WithRounding(RoundExact)).IntE("2.5"); !errors.Is(err, ErrPrecision) {
+		t.Errorf("IntE(\"2.5\"): expected ErrPrecision, got %v", err)
+	}
+}
+
+func TestConverter_NonFinite(t *testing.T) {
+	inputs := []any{math.NaN(), math.Inf(1), "-Inf", float32(math.Inf(-1)), namedFloat(math.NaN())}
+	for _, in := range inputs {
+		if _, err := IntE[int](in); !errors.Is(err, ErrNonFinite) {
+			t.Errorf("IntE(%v): expected ErrNonFinite, got %v", in, err)
+		}
+		if _, err := UintE[uint](in); !errors.Is(err, ErrNonFinite) {
+			t.Errorf("UintE(%v): expected ErrNonFinite, got %v", in, err)
+		}
+		if _, err := FloatE[float64](in); !errors.Is(err, ErrNonFinite) {
+			t.Errorf("FloatE(%v): expected ErrNonFinite, got %v", in, err)
+		}
+		if _, ok := in.(string); ok {
+			// Durations and booleans parse strings with their own syntax.
+			continue
+		}
+		if _, err := DurationE(in); !errors.Is(err, ErrNonFinite) {
+			t.Errorf("DurationE(%v): expected ErrNonFinite, got %v", in, err)
+		}
+		if _, err := BoolE[bool](in); !errors.Is(err, ErrNonFinite) {
+			t.Errorf("BoolE(%v): expected ErrNonFinite, got %v", in, err)
+		}
+	}
+
+	zero := New(WithNonFinite(NonFiniteZero))
+	if v, err := zero.IntE(math.Inf(1)); err != nil || v != 0 {
+		t.Errorf("zero IntE(+Inf) = %v, %v", v, err)
+	}
+	if v, err := zero.Float64E("NaN"); err != nil || v != 0 {
+		t.Errorf("zero Float64E(NaN) = %v, %v", v, err)
+	}
+	if v, err := zero.BoolE(math.NaN()); err != nil || v {
+		t.Errorf("zero BoolE(NaN) = %v, %v", v, err)
+	}
+
+	saturate := New(WithNonFinite(NonFiniteSaturate))
+	if v, err := saturate.Int8E(math.Inf(1)); err != nil || v != math.MaxInt8 {
+		t.Errorf("saturate Int8E(+Inf) = %v, %v", v, err)
+	}
+	if v, err := saturate.Int64E("-Inf"); err != nil || v != math.MinInt64 {
+		t.Errorf("saturate Int64E(-Inf) = %v, %v", v, err)
+	}
+	if v, err := saturate.Uint16E(math.Inf(-1)); err != nil || v != 0 {
+		t.Errorf("saturate Uint16E(-Inf) = %v, %v", v, err)
+	}
+	if v, err := saturate.Float32E(math.Inf(-1)); err != nil || v != -math.MaxFloat32 {
+		t.Errorf("saturate Float32E(-Inf) = %v, %v", v, err)
+	}
+	if v, err := saturate.DurationE(math.Inf(1)); err != nil || v != math.MaxInt64 {
+		t.Errorf("saturate DurationE(+Inf) = %v, %v", v, err)
+	}
+	if v, err := saturate.IntE(math.NaN()); err != nil || v != 0 {
+		t.Errorf("saturate IntE(NaN) = %v, %v", v, err)
+	}
+}
+
+func TestConverter_Overflow(t *testing.T) {
+	saturate := New(WithOverflow(OverflowSaturate))
+	if v, err := saturate.Int16E(100000); err != nil || v != math.MaxInt16 {
+		t.Errorf("saturate Int16E(100000) = %v, %v", v, err)
+	}
+	if v, err := saturate.Int8E("-1000"); err != nil || v != math.MinInt8 {
+		t.Errorf("saturate Int8E(\"-1000\") = %v, %v", v, err)
+	}
+	if v, err := saturate.Int64E("99999999999999999999"); err != nil || v != math.MaxInt64 {
+		t.Errorf("saturate Int64E(huge) = %v, %v", v, err)
+	}
+	if v, err := saturate.Int32E(1e20); err != nil || v != math.MaxInt32 {
+		t.Errorf("saturate Int32E(1e20) = %v, %v", v, err)
+	}
+	if v, err := saturate.Uint8E(-5); err != nil || v != 0 {
+		t.Errorf("saturate Uint8E(-5) = %v, %v", v, err)
+	}
+	if v, err := saturate.Uint8E("-5"); err != nil || v != 0 {
+		t.Errorf("saturate Uint8E(\"-5\") = %v, %v", v, err)
+	}
+	if v, err := saturate.Uint16E(uint64(1 << 40)); err != nil || v != math.MaxUint16 {
+		t.Errorf("saturate Uint16E(1<<40) = %v, %v", v, err)
+	}
+	if v, err := saturate.DurationE(uint64(math.MaxUint64)); err != nil || v != math.MaxInt64 {
+		t.Errorf("saturate DurationE(MaxUint64) = %v, %v", v, err)
+	}
+
+	wrap := New(WithOverflow(OverflowWrap))
+	if v, err := wrap.Int8E(300); err != nil || v != 44 {
+		t.Errorf("wrap Int8E(300) = %v, %v", v, err)
+	}
+	if v, err := wrap.Uint8E(300.0); err != nil || v != 44 {
+		t.Errorf("wrap Uint8E(300.0) = %v, %v", v, err)
+	}
+	if _, err := wrap.Uint8E(-5); !errors.Is(err, ErrNegative) {
+		t.Errorf("wrap Uint8E(-5): expected ErrNegative, got %v", err)
+	}
+	if v, err := wrap.Int64E(math.Ldexp(1, 64) + 4096); err != nil || v != 4096 {
+		t.Errorf("wrap Int64E(2^64+4096) = %v, %v", v, err)
+	}
+	if v, err := wrap.Int64E(-math.Ldexp(1, 63) - 2048); err != nil || v != math.MaxInt64-2047 {
+		t.Errorf("wrap Int64E(-(2^63+2048)) = %v, %v", v, err)
+	}
+	if v, err := wrap.Int64E(1e20); err != nil || v != 7766279631452241920 {
+		t.Errorf("wrap Int64E(1e20) = %v, %v", v, err)
+	}
+	if v, err := wrap.Int64E("100000000000000000000"); err != nil || v != 7766279631452241920 {
+		t.Errorf("wrap Int64E(\"100000000000000000000\") = %v, %v", v, err)
+	}
+	if v, err := wrap.Int8E("-99999999999999999999"); err != nil || v != 1 {
+		t.Errorf("wrap Int8E(\"-99999999999999999999\") = %v, %v", v, err)
+	}
+	if v, err := wrap.Uint64E("18446744073709551617"); err != nil || v != 1 {
+		t.Errorf("wrap Uint64E(\"18446744073709551617\") = %v, %v", v, err)
+	}
+
+	if _, err := IntE[int16](100000); !errors.Is(err, ErrOverflow) {
+		t.Errorf("default IntE[int16](100000): expected ErrOverflow, got %v", err)
+	}
+}
+
+func TestConverter_Options(t *testing.T) {
+	if v := New(WithBase(10)).Int("010"); v != 10 {
+		t.Errorf("base 10 Int(\"010\") = %v", v)
+	}
+	if v := Int[int]("010"); v != 8 {
+		t.Errorf("default Int(\"010\") = %v", v)
+	}
+	if v := New(WithBase(16)).Uint("ff"); v != 255 {
+		t.Errorf("base 16 Uint(\"ff\") = %v", v)
+	}
+
+	strict := New(WithStrict())
+	if _, err := strict.IntE(true); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("strict IntE(true): expected ErrUnsupported, got %v", err)
+	}
+	if _, err := strict.BoolE(1); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("strict BoolE(1): expected ErrUnsupported, got %v", err)
+	}
+	if v, err := strict.BoolE("true"); err != nil || !v {
+		t.Errorf("strict BoolE(\"true\") = %v, %v", v, err)
+	}
+
+	words := New(WithBoolStrings([]string{"yes", "on"}, []string{"no", "off"}))
+	if v, err := words.BoolE("YES"); err != nil || !v {
+		t.Errorf("vocabulary BoolE(\"YES\") = %v, %v", v, err)
+	}
+	if _, err := words.BoolE("true"); !errors.Is(err, ErrSyntax) {
+		t.Errorf("vocabulary BoolE(\"true\"): expected ErrSyntax, got %v", err)
+	}
+
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	c := New(WithLocation(loc), WithTimeFormats("02/01/2006 15:04"), WithTimeFormat(time.DateOnly))
+	tm, err := c.TimeE("02/01/2023 10:00")
+	if err != nil || !tm.Equal(time.Date(2023, 1, 2, 10, 0, 0, 0, loc)) {
+		t.Errorf("TimeE = %v, %v", tm, err)
+	}
+	if _, err := c.TimeE("2023-01-02T10:00:00Z"); err == nil {
+		t.Errorf("expected layouts of the converter to replace the defaults")
+	}
+	if s := c.String(tm); s != "2023-01-02" {
+		t.Errorf("String(time) = %q", s)
+	}
+	if s := String[string](tm); s != tm.Format(DefaultTimeFormat) {
+		t.Errorf("default String(time) = %q", s)
+	}
+}
+
+func TestConverter_InvalidElementsCollect(t *testing.T) {
+	collect := WithInvalidElements(InvalidElementsCollect)
+
+	v, err := IntSliceE[[]int]([]any{"1", "x", 3, "y"}, collect)
+	var elemsErr *ElementsError
+	if v != nil || !errors.As(err, &elemsErr) || len(elemsErr.Errs) != 2 {
+		t.Fatalf("IntSliceE() = %v, %v", v, err)
+	}
+	if !reflect.DeepEqual(elemsErr.Keys, []any{1, 3}) || !errors.Is(err, ErrSyntax) {
+		t.Fatalf("unexpected keys %v in %v", elemsErr.Keys, err)
+	}
+	var castErr *CastError
+	if !errors.As(err, &castErr) || castErr.Path != "[1]" {
+		t.Fatalf("expected first failure at [1], got %v", err)
+	}
+
+	_, err = StringIntMapE[string, int](map[string]string{"b": "x", "a": "y", "c": "3"}, collect)
+	if !errors.As(err, &elemsErr) || !reflect.DeepEqual(elemsErr.Keys, []any{"a", "b"}) {
+		t.Fatalf("StringIntMapE() = %v", err)
+	}
+
+	_, err = New(collect).ConvertToType([][]string{{"x"}, {"1", "y"}}, reflect.TypeOf([][]int{}))
+	if !errors.As(err, &elemsErr) || !reflect.DeepEqual(elemsErr.Keys, []any{0, 1}) || len(elemsErr.Errs) != 2 {
+		t.Fatalf("ConvertToType() = %v", err)
+	}
+	paths := []string{elemsErr.Errs[0].(*CastError).Path, elemsErr.Errs[1].(*CastError).Path}
+	if !reflect.DeepEqual(paths, []string{"[0][0]", "[1][1]"}) {
+		t.Fatalf("unexpected paths %v", paths)
+	}
+
+	if v, err := IntSliceE[[]int]([]string{"1", "2"}, collect); err != nil || !reflect.DeepEqual(v, []int{1, 2}) {
+		t.Fatalf("IntSliceE() = %v, %v", v, err)
+	}
+	if _, err := IntSliceE[[]int]([]string{"1", "x", "y"}); errors.As(err, &elemsErr) {
+		t.Fatalf("expected the default to stop at the first failure, got %v", err)
+	}
+}
+
+func TestConverter_InvalidElementsLenient(t *testing.T) {
+	var elemsErr *ElementsError
+	v, err := BoolSE[[]bool]([]string{"true", "invalid", "0"}, WithInvalidElements(InvalidElementsSkip))
+	if !reflect.DeepEqual(v, []bool{true, false}) || !errors.As(err, &elemsErr) || !reflect.DeepEqual(elemsErr.Keys, []any{1}) {
+		t.Fatalf("BoolSE(skip) = %v, %v", v, err)
+	}
+	v, err = BoolSE[[]bool]([]string{"true", "invalid", "0"}, WithInvalidElements(InvalidElementsReplace))
+	if !reflect.DeepEqual(v, []bool{true, false, false}) || !errors.As(err, &elemsErr) || !reflect.DeepEqual(elemsErr.Keys, []any{1}) {
+		t.Fatalf("BoolSE(replace) = %v, %v", v, err)
+	}
+	ints, err := SliceE[[]int]([]any{"1", "x", 3, "y"}, IntE[int], WithElementDefault("-1"))
+	if !reflect.DeepEqual(ints, []int{1, -1, 3, -1}) || !errors.As(err, &elemsErr) || !reflect.DeepEqual(elemsErr.Keys, []any{1, 3}) {
+		t.Fatalf("SliceE(default) = %v, %v", ints, err)
+	}
+	ints = IntSlice[[]int]([]string{"1", "x"}, WithInvalidElements(InvalidElementsSkip))
+	if !reflect.DeepEqual(ints, []int{1}) {
+		t.Fatalf("IntSlice(skip) = %v", ints)
+	}
+	if ds, err := DurationSE([]string{"1s", "1s"}, WithInvalidElements(InvalidElementsSkip)); err != nil || len(ds) != 2 {
+		t.Fatalf("DurationSE(skip) = %v, %v", ds, err)
+	}
+
+	m, err := StringIntMapE[string, int](testAddress{City: "x"}, WithElementDefault(-1))
+	if !reflect.DeepEqual(m, map[string]int{"city": -1, "zip": 0}) || !errors.As(err, &elemsErr) || !reflect.DeepEqual(elemsErr.Keys, []any{"city"}) {
+		t.Fatalf("StringIntMapE(default) = %v, %v", m, err)
+	}
+
+	c := New(WithInvalidElements(InvalidElementsSkip))
+	nested, err := c.ConvertToType([][]string{{"1", "x"}, {"2"}}, reflect.TypeOf([][]int{}))
+	if !reflect.DeepEqual(nested.Interface(), [][]int{{1}, {2}}) || !errors.As(err, &elemsErr) || elemsErr.Errs[0].(*CastError).Path != "[0][1]" {
+		t.Fatalf("ConvertToType(skip) = %v, %v", nested, err)
+	}
+	var dst []int
+	if err := c.ConvertInto(&dst, []string{"1", "x", "3"}); !reflect.DeepEqual(dst, []int{1, 3}) || err == nil {
+		t.Fatalf("ConvertInto(skip) = %v, %v", dst, err)
+	}
+	if _, err := c.ConvertToType([]string{"x"}, reflect.TypeOf((*int)(nil))); err == nil {
+		t.Fatalf("expected scalar failures to be reported as usual")
+	}
+}
@@ -0,0 +1,172 @@
+package gonv
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// CopyError reports the fields of the destination struct that Copy could not set.
+// The fields that are not listed were copied, so the destination is usable after a *CopyError
+// if the caller accepts the missing fields.
+// Copy returns a *CopyError if a field failed to convert, or, with WithRequiredFields, if a field was skipped.
+//
+// Example:
+//
+//	err := Copy(&dst, src)
+//	var copyErr *CopyError
+//	if errors.As(err, &copyErr) {
+//		// copyErr.Skipped = []string{"CreatedBy"}, copyErr.Failed = map[string]error{"Age": ...}
+//	}
+type CopyError struct {
+	// Skipped lists the fields of the destination that have no matching field in the source.
+	// They are reported whenever a *CopyError is returned, but only fail the copy with WithRequiredFields.
+	Skipped []string
+	// Failed maps the fields of the destination whose conversion failed to the conversion error.
+	Failed map[string]error
+}
+
+// Error implements the error interface, listing the skipped fields and then the failed ones.
+func (e *CopyError) Error() string {
+	var b strings.Builder
+	b.WriteString("gonv: failed to copy struct")
+	if len(e.Skipped) > 0 {
+		fmt.Fprintf(&b, ", skipped fields %s", strings.Join(e.Skipped, ", "))
+	}
+	for _, name := range e.failedFields() {
+		fmt.Fprintf(&b, ", field %s: %v", name, e.Failed[name])
+	}
+	return b.String()
+}
+
+// Unwrap returns the conversion errors of the failed fields, ordered by field name,
+// so that errors.Is and errors.As match any of them.
+func (e *CopyError) Unwrap() []error {
+	names := e.failedFields()
+	errs := make([]error, len(names))
+	for i, name := range names {
+		errs[i] = e.Failed[name]
+	}
+	return errs
+}
+
+// failedFields returns the names of the failed fields in sorted order.
+func (e *CopyError) failedFields() []string {
+	names := make([]string, 0, len(e.Failed))
+	for name := range e.Failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Copy copies the fields of the struct src into the struct dst points to, converting each field to its type.
+// Fields are matched by the names from their `gonv` or `json` tags, falling back to their Go names and
+// then to a case-insensitive match, so DTOs, domain structs and generated structs can be copied into each other
+// even where the types of their fields differ, such as string and int64, time.Time and string, or *int and int.
+// Nil source pointers set the destination field to its zero value, and nested structs are copied recursively.
+// src may be a struct or a pointer to one, and dst must be a non-nil pointer to a struct.
+//
+// All fields that can be copied are copied, and fields of dst without a match in src are left as they are.
+// If any field fails to convert, a *CopyError listing it is returned; failed fields keep their previous value.
+// opts apply Converter options to this copy only; WithSkippedFields reports the unmatched fields
+// and WithRequiredFields also fails the copy on them.
+//
+// Example:
+//
+//	type UserDTO struct {
+//		ID   string `json:"id"`
+//		Born string `json:"born"`
+//	}
+//	type User struct {
+//		ID   int64     `json:"id"`
+//		Born time.Time `json:"born"`
+//	}
+//
+//	var user User
+//	err := Copy(&user, UserDTO{ID: "42", Born: "2000-01-02"})
+//	// user = User{ID: 42, Born: time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)}, err = nil
+//	err := Copy(&user, struct{ ID string }{"42"}, WithRequiredFields())
+//	// user.ID = 42, err lists Born as skipped
+//	var skipped []string
+//	err := Copy(&user, struct{ ID string }{"42"}, WithSkippedFields(&skipped))
+//	// user.ID = 42, err = nil, skipped = []string{"Born"}
+func Copy(dst, src any, opts ...Option) error {
+	return copyStruct(converterWith(opts), dst, src)
+}
+
+// copyStruct is the core implementation of Copy.
+func copyStruct(c *Converter, dst, src any) error {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Pointer || d.Type().Elem().Kind() != reflect.Struct {
+		return castError(src, reflect.TypeOf(dst), ErrUnsupported)
+	}
+	if d.IsNil() {
+		return castError(src, d.Type(), ErrNil)
+	}
+	s := indirectValue(reflect.ValueOf(src))
+	if s.Kind() == reflect.Pointer {
+		return castError(src, d.Type(), ErrNil)
+	}
+	if s.Kind() != reflect.Struct {
+		return castError(src, d.Type(), ErrUnsupported)
+	}
+
+	d = d.Elem()
+	srcFields := structFields(s.Type())
+	var copyErr CopyError
+	for _, f := range structFields(d.Type()) {
+		sf, ok := matchField(srcFields, f)
+		if !ok {
+			copyErr.Skipped = append(copyErr.Skipped, f.goName)
+			continue
+		}
+		sv, ok := fieldByIndexNoAlloc(s, sf.index)
+		if !ok {
+			copyErr.Skipped = append(copyErr.Skipped, f.goName)
+			continue
+		}
+		// Nil pointers and interfaces leave nothing to convert
+		if (sv.Kind() == reflect.Pointer || sv.Kind() == reflect.Interface) && sv.IsNil() {
+			fieldByIndex(d, f.index).Set(reflect.Zero(f.typ))
+			continue
+		}
+		v, err := convertTo(c, sv.Interface(), f.typ)
+		if err != nil {
+			if copyErr.Failed == nil {
+				copyErr.Failed = make(map[string]error)
+			}
+			copyErr.Failed[f.goName] = atPath(err, f.name)
+			continue
+		}
+		fieldByIndex(d, f.index).Set(v)
+	}
+	if c.skipped != nil {
+		*c.skipped = append((*c.skipped)[:0], copyErr.Skipped...)
+	}
+	if len(copyErr.Failed) > 0 || c.required && len(copyErr.Skipped) > 0 {
+		return &copyErr
+	}
+	return nil
+}
+
+// matchField returns the field of fields matching f by tag name, by Go name or case-insensitively by tag name.
+func matchField(fields []structField, f structField) (structField, bool) {
+	for _, sf := range fields {
+		if sf.name == f.name {
+			return sf, true
+		}
+	}
+	for _, sf := range fields {
+		if sf.goName == f.goName {
+			return sf, true
+		}
+	}
+	for _, sf := range fields {
+		if strings.EqualFold(sf.name, f.name) {
+			return sf, true
+		}
+	}
+	return structField{}, false
+}
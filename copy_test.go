@@ -0,0 +1,99 @@
+package gonv
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type (
+	testUserDTO struct {
+		ID      string         `json:"id"`
+		Born    string         `json:"born"`
+		Age     *int           `json:"age"`
+		Score   string         `json:"score"`
+		Address map[string]any `json:"address"`
+		Home    testAddress    `json:"home"`
+	}
+	testUserModel struct {
+		ID        int64     `gonv:"id"`
+		Born      time.Time `json:"born"`
+		Age       int
+		Score     float64      `json:"score"`
+		Address   testAddress  `json:"address"`
+		Home      *testAddress `json:"home"`
+		CreatedBy string       `json:"created_by"`
+	}
+)
+
+func TestCopy(t *testing.T) {
+	age := 30
+	var got testUserModel
+	err := Copy(&got, &testUserDTO{
+		ID:      "42",
+		Born:    "2000-01-02T00:00:00Z",
+		Age:     &age,
+		Score:   "9.5",
+		Address: map[string]any{"city": "Berlin"},
+		Home:    testAddress{City: "Paris"},
+	})
+	if err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	want := testUserModel{
+		ID:      42,
+		Born:    time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC),
+		Age:     30,
+		Score:   9.5,
+		Address: testAddress{City: "Berlin"},
+		Home:    &testAddress{City: "Paris"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Copy() = %+v, want %+v", got, want)
+	}
+
+	var skipped []string
+	var lenient testUserModel
+	err = Copy(&lenient, testUserDTO{ID: "42", Born: "2000-01-02T00:00:00Z", Score: "1"}, WithSkippedFields(&skipped))
+	if err != nil || !reflect.DeepEqual(skipped, []string{"CreatedBy"}) || lenient.ID != 42 {
+		t.Fatalf("Copy(WithSkippedFields) = %+v, %v, skipped %v", lenient, err, skipped)
+	}
+
+	var strict testUserModel
+	err = Copy(&strict, testUserDTO{ID: "42", Born: "2000-01-02T00:00:00Z", Score: "1"}, WithRequiredFields())
+	var copyErr *CopyError
+	if !errors.As(err, &copyErr) || !reflect.DeepEqual(copyErr.Skipped, []string{"CreatedBy"}) || len(copyErr.Failed) != 0 || strict.ID != 42 {
+		t.Fatalf("Copy(WithRequiredFields) = %+v, %v", strict, err)
+	}
+
+	var back testUserDTO
+	if err := Copy(&back, want); err != nil {
+		t.Fatalf("Copy() back error = %v", err)
+	}
+	if back.ID != "42" || back.Age == nil || *back.Age != 30 || back.Home.City != "Paris" || back.Address["city"] != "Berlin" {
+		t.Errorf("Copy() back = %+v", back)
+	}
+}
+
+func TestCopy_Errors(t *testing.T) {
+	got := testUserModel{ID: 7, Age: 3}
+	err := Copy(&got, testUserDTO{ID: "x", Score: "1.5"})
+	var copyErr *CopyError
+	if !errors.As(err, &copyErr) || copyErr.Failed["ID"] == nil || !errors.Is(err, ErrSyntax) {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if got.ID != 7 || got.Age != 0 || got.Score != 1.5 {
+		t.Errorf("Copy() = %+v", got)
+	}
+
+	if err := Copy(testUserModel{}, testUserDTO{}); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Copy(non-pointer) = %v", err)
+	}
+	if err := Copy((*testUserModel)(nil), testUserDTO{}); !errors.Is(err, ErrNil) {
+		t.Errorf("Copy(nil) = %v", err)
+	}
+	if err := Copy(&got, 42); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Copy(int) = %v", err)
+	}
+}
@@ -0,0 +1,206 @@
+package gonv
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// stringEntries returns the entries of a map string that does not unmarshal into the target map directly:
+// the members of a JSON object in key order, or otherwise the pairs of a delimited string such as
+// "env=prod,team=core" or "a:1;b:2" in order, split by the separators of c.
+func stringEntries(c *Converter, s string) ([]mapEntry, error) {
+	if strings.HasPrefix(strings.TrimSpace(s), "{") {
+		obj, err := decodeObject(s)
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		entries := make([]mapEntry, len(keys))
+		for i, k := range keys {
+			entries[i] = mapEntry{key: k, val: obj[k]}
+		}
+		return entries, nil
+	}
+	return parseDelimited(c, s)
+}
+
+// decodeObject decodes the JSON object s, keeping numbers as json.Number
+// so that large integers keep their precision.
+// Invalid JSON is reported with the errors of json.Unmarshal, such as *json.SyntaxError.
+func decodeObject(s string) (map[string]any, error) {
+	if err := json.Unmarshal([]byte(s), new(json.RawMessage)); err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// parseDelimited parses s as pairs separated by the pair separators of c,
+// each holding a key and a value separated by the first key/value separator of c it contains.
+// Empty pairs are ignored, and separators preceded by the escape character of c are taken literally.
+//
+// Example:
+//
+//	parseDelimited(c, "env=prod, team=core") // returns [{env prod} {team core}], nil
+//	parseDelimited(c, "env")                // returns nil, error
+func parseDelimited(c *Converter, s string) ([]mapEntry, error) {
+	var entries []mapEntry
+	for _, pair := range splitEscaped(s, c.pairSeps, c.escape, -1) {
+		if c.trim(pair) == "" {
+			continue
+		}
+		kv := splitEscaped(pair, c.keyValSeps, c.escape, 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("%w: missing key/value separator in %q", ErrSyntax, pair)
+		}
+		entries = append(entries, mapEntry{
+			key: unescape(c.trim(kv[0]), c.escape),
+			val: unescape(c.trim(kv[1]), c.escape),
+		})
+	}
+	return entries, nil
+}
+
+// stringElements returns the elements of a slice string: the elements of a JSON array,
+// with numbers kept as json.Number so that large integers keep their precision,
+// or otherwise the elements of a delimited string such as "1s, 5m".
+//
+// Example:
+//
+//	stringElements(c, `["a", 1]`) // returns []any{"a", json.Number("1")}, nil
+//	stringElements(c, "a, b")     // returns []string{"a", "b"}, nil
+func stringElements(c *Converter, s string) (any, error) {
+	if !strings.HasPrefix(strings.TrimSpace(s), "[") {
+		return c.splitElements(s), nil
+	}
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var arr []any
+	if err := dec.Decode(&arr); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("%w: unexpected data after JSON array in %q", ErrSyntax, s)
+	}
+	return arr, nil
+}
+
+// isText reports whether b holds text rather than raw bytes:
+// valid UTF-8 made only of printable characters and white space.
+func isText(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitElements splits the delimited slice string s into its elements at the slice separators of c,
+// trimming them and dropping empty ones unless c keeps them.
+// Separators preceded by the escape character of c are taken literally.
+//
+// Example:
+//
+//	c.splitElements("1s, 5m") // returns []string{"1s", "5m"}
+func (c *Converter) splitElements(s string) []string {
+	parts := splitEscaped(s, c.sliceSeps, c.escape, -1)
+	elems := parts[:0]
+	for _, part := range parts {
+		part = c.trim(part)
+		if part == "" && !c.keepEmpty {
+			continue
+		}
+		elems = append(elems, unescape(part, c.escape))
+	}
+	return elems
+}
+
+// trim removes the white space around s unless c keeps it.
+func (c *Converter) trim(s string) string {
+	if c.noTrim {
+		return s
+	}
+	return strings.TrimSpace(s)
+}
+
+// splitEscaped splits s at each occurrence of any of seps that is not preceded by escape,
+// returning at most n parts if n is positive. The parts keep their escape characters.
+// Empty separators are ignored, and an escape of 0 disables escaping.
+//
+// Example:
+//
+//	splitEscaped(`a\,b,c`, []string{","}, '\\', -1) // returns []string{`a\,b`, "c"}
+func splitEscaped(s string, seps []string, escape rune, n int) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); {
+		if n > 0 && len(parts) == n-1 {
+			break
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if escape != 0 && r == escape {
+			// Skip the escape character and the character it escapes
+			i += size
+			if i < len(s) {
+				_, size = utf8.DecodeRuneInString(s[i:])
+				i += size
+			}
+			continue
+		}
+		if sep := matchSeparator(s[i:], seps); sep != "" {
+			parts = append(parts, s[start:i])
+			i += len(sep)
+			start = i
+			continue
+		}
+		i += size
+	}
+	return append(parts, s[start:])
+}
+
+// matchSeparator returns the longest of seps that s starts with, or "" if there is none.
+func matchSeparator(s string, seps []string) string {
+	var match string
+	for _, sep := range seps {
+		if sep != "" && len(sep) > len(match) && strings.HasPrefix(s, sep) {
+			match = sep
+		}
+	}
+	return match
+}
+
+// unescape removes the escape characters from s, keeping the characters they escape.
+func unescape(s string, escape rune) string {
+	if escape == 0 || !strings.ContainsRune(s, escape) {
+		return s
+	}
+	var b strings.Builder
+	escaped := false
+	for _, r := range s {
+		if r == escape && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
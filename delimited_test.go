@@ -0,0 +1,89 @@
+package gonv
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMapE_Delimited(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		opts  []Option
+		want  map[string]string
+	}{
+		{"comma and equals", "env=prod,team=core", nil, map[string]string{"env": "prod", "team": "core"}},
+		{"semicolon and colon", "a:1;b:2", nil, map[string]string{"a": "1", "b": "2"}},
+		{"trimmed", " env = prod , team = core ,", nil, map[string]string{"env": "prod", "team": "core"}},
+		{"first separator wins", "at=12:00", nil, map[string]string{"at": "12:00"}},
+		{"escaped", `path=a\,b\=c,quote=\\`, nil, map[string]string{"path": "a,b=c", "quote": `\`}},
+		{"empty", "", nil, map[string]string{}},
+		{"pair separators", "env=prod&team=core", []Option{WithPairSeparators("&")}, map[string]string{"env": "prod", "team": "core"}},
+		{"key value separators", "a->1,b->2", []Option{WithKeyValueSeparators("->")}, map[string]string{"a": "1", "b": "2"}},
+		{"untrimmed", "a = 1", []Option{WithTrimSpace(false)}, map[string]string{"a ": " 1"}},
+		{"unescaped", `a=\,b`, []Option{WithEscape(0), WithPairSeparators(";")}, map[string]string{"a": `\,b`}},
+		{"newlines", "a=1\nb=2", []Option{WithPairSeparators("\n")}, map[string]string{"a": "1", "b": "2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringStringMapE[string, string](tt.input, tt.opts...)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("StringStringMapE(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+			}
+		})
+	}
+
+	if v, err := StringIntMapE[string, int]("a:1;b:2"); err != nil || !reflect.DeepEqual(v, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("StringIntMapE() = %v, %v", v, err)
+	}
+	c := New(WithBoolStrings([]string{"yes"}, []string{"no"}))
+	if v, err := c.ConvertToType("1=yes, 2=no", reflect.TypeOf(map[int]bool{})); err != nil || !reflect.DeepEqual(v.Interface(), map[int]bool{1: true, 2: false}) {
+		t.Errorf("ConvertToType() = %v, %v", v, err)
+	}
+}
+
+func TestMapE_JSONNumbers(t *testing.T) {
+	const in = `{"a": 9007199254740993, "b": "2"}`
+	want := map[string]int64{"a": 9007199254740993, "b": 2}
+	if m, err := StringIntMapE[string, int64](in); err != nil || !reflect.DeepEqual(m, want) {
+		t.Errorf("StringIntMapE() = %v, %v", m, err)
+	}
+	if m, err := To[map[string]int64](in); err != nil || !reflect.DeepEqual(m, want) {
+		t.Errorf("To[map[string]int64]() = %v, %v", m, err)
+	}
+}
+
+func TestMapE_DelimitedErrors(t *testing.T) {
+	if _, err := StringStringMapE[string, string]("env"); !errors.Is(err, ErrSyntax) {
+		t.Errorf("missing separator = %v", err)
+	}
+	var syntaxErr *json.SyntaxError
+	if _, err := StringStringMapE[string, string](`{"a": `); !errors.As(err, &syntaxErr) {
+		t.Errorf("broken JSON object = %v", err)
+	}
+	_, err := StringIntMapE[string, int]("a=1,b=x")
+	var castErr *CastError
+	if !errors.As(err, &castErr) || castErr.Path != `["b"]` {
+		t.Errorf("bad value = %v", err)
+	}
+}
+
+func TestConverter_SeparatorsCopied(t *testing.T) {
+	pairSeps, keyValSeps := []string{"&"}, []string{"="}
+	c := New(WithPairSeparators(pairSeps...), WithKeyValueSeparators(keyValSeps...))
+	pairSeps[0], keyValSeps[0] = ",", ":"
+	m, err := c.ConvertToType("a=1&b=2", reflect.TypeOf(map[string]int{}))
+	if err != nil || !reflect.DeepEqual(m.Interface(), map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("ConvertToType() = %v, %v", m, err)
+	}
+
+	sliceSeps := []string{"|"}
+	c = New(WithSliceSeparators(sliceSeps...))
+	sliceSeps[0] = ","
+	v, err := c.ConvertToType("1|2", reflect.TypeOf([]int{}))
+	if err != nil || !reflect.DeepEqual(v.Interface(), []int{1, 2}) {
+		t.Errorf("ConvertToType(slice) = %v, %v", v, err)
+	}
+}
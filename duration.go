@@ -11,6 +11,21 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// Durationer is implemented by types that can convert themselves to a time.Duration.
+// The duration converter calls GonvDuration before falling back to
+// driver.Valuer, fmt.Stringer and reflection.
+//
+// Example:
+//
+//	type Timeout struct{ seconds int }
+//
+//	func (t Timeout) GonvDuration() (time.Duration, error) { return time.Duration(t.seconds) * time.Second, nil }
+//
+//	result := Duration(Timeout{seconds: 5}) // returns 5s
+type Durationer interface {
+	GonvDuration() (time.Duration, error)
+}
+
 // Duration casts an interface to a time.Duration type, ignoring any conversion errors.
 // It returns zero duration if conversion fails.
 //
@@ -32,7 +47,7 @@ func Duration(o any) time.Duration {
 //	result, err := DurationE("1h30m") // returns 5400000000000, nil
 //	result, err := DurationE("invalid") // returns 0, error
 func DurationE(o any) (time.Duration, error) {
-	return durationE(o)
+	return durationE(defaultConverter, o)
 }
 
 // DurationS casts an interface to a []time.Duration type, ignoring any conversion errors.
@@ -41,36 +56,44 @@ func DurationE(o any) (time.Duration, error) {
 // Example:
 //
 //	result := DurationS([]string{"1h", "30m", "45s"}) // returns []time.Duration{3600000000000, 1800000000000, 45000000000}
-func DurationS(o any) []time.Duration {
-	v, _ := DurationSE(o)
+func DurationS(o any, opts ...Option) []time.Duration {
+	v, _ := DurationSE(o, opts...)
 	return v
 }
 
 // DurationSE casts an interface to a []time.Duration type, returning both the converted slice and any error encountered.
 // This function is useful when you need to handle conversion errors for slice data explicitly.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := DurationSE([]string{"1h", "30m"}) // returns []time.Duration{3600000000000, 1800000000000}, nil
+//	result, err := DurationSE("1s, 5m") // returns []time.Duration{1000000000, 300000000000}, nil
 //	result, err := DurationSE([]string{"1h", "invalid"}) // returns nil, error
-func DurationSE(o any) ([]time.Duration, error) {
-	return toSliceE[[]time.Duration](o, DurationE)
+func DurationSE(o any, opts ...Option) ([]time.Duration, error) {
+	c := converterWith(opts)
+	return toSliceE[[]time.Duration](c, o, bind(c, durationE))
 }
 
 // durationE is the core implementation of duration conversion with error handling.
 // It uses a fast path approach for common types and falls back to reflection for complex types.
-func durationE(o any) (time.Duration, error) {
+func durationE(c *Converter, o any) (time.Duration, error) {
 	// Handle nil input by returning zero duration
 	if o == nil {
 		var zero time.Duration
 		return zero, nil
 	}
 
+	// Registered conversions take precedence over the built-in ones
+	if r, ok, err := registered[time.Duration](o); ok {
+		return r, err
+	}
+
 	// Fast path: direct type assertions for common types
 	switch d := o.(type) {
 	// String conversion using time.ParseDuration
 	case string:
-		v, err := time.ParseDuration(d)
+		v, err := parseDuration(d)
 		if err != nil {
 			return failedCastErrValue[time.Duration](o, err)
 		}
@@ -78,7 +101,7 @@ func durationE(o any) (time.Duration, error) {
 
 	// Byte slice conversion by converting to string first
 	case []byte:
-		v, err := time.ParseDuration(string(d))
+		v, err := parseDuration(string(d))
 		if err != nil {
 			return failedCastErrValue[time.Duration](o, err)
 		}
@@ -94,7 +117,7 @@ func durationE(o any) (time.Duration, error) {
 
 	// Protobuf string wrapper support
 	case *wrapperspb.StringValue:
-		duration, err := time.ParseDuration(d.GetValue())
+		duration, err := parseDuration(d.GetValue())
 		if err != nil {
 			return failedCastErrValue[time.Duration](o, err)
 		}
@@ -102,7 +125,7 @@ func durationE(o any) (time.Duration, error) {
 
 	// Protobuf bytes wrapper support
 	case *wrapperspb.BytesValue:
-		duration, err := time.ParseDuration(string(d.GetValue()))
+		duration, err := parseDuration(string(d.GetValue()))
 		if err != nil {
 			return failedCastErrValue[time.Duration](o, err)
 		}
@@ -120,11 +143,15 @@ func durationE(o any) (time.Duration, error) {
 		*wrapperspb.Int32Value,
 		*wrapperspb.UInt64Value,
 		*wrapperspb.UInt32Value:
-		duration, err := intE[time.Duration](o)
+		return intE[time.Duration](c, o)
+
+	// Durationer interface support for types that convert themselves to durations
+	case Durationer:
+		v, err := d.GonvDuration()
 		if err != nil {
 			return failedCastErrValue[time.Duration](o, err)
 		}
-		return time.Duration(duration), nil
+		return v, nil
 
 	// Database driver.Valuer interface support
 	case driver.Valuer:
@@ -132,7 +159,7 @@ func durationE(o any) (time.Duration, error) {
 		if err != nil {
 			return failedCastErrValue[time.Duration](o, err)
 		}
-		r, err := durationE(v)
+		r, err := durationE(c, v)
 		if err != nil {
 			return failedCastErrValue[time.Duration](o, err)
 		}
@@ -140,7 +167,7 @@ func durationE(o any) (time.Duration, error) {
 
 	// Stringer interface support for custom types that can be represented as strings
 	case fmt.Stringer:
-		v, err := time.ParseDuration(d.String())
+		v, err := parseDuration(d.String())
 		if err != nil {
 			return failedCastErrValue[time.Duration](o, err)
 		}
@@ -149,13 +176,13 @@ func durationE(o any) (time.Duration, error) {
 	// Default case: use reflection-based conversion for complex types
 	default:
 		// slow path
-		return durationVE(o)
+		return durationVE(c, o)
 	}
 }
 
 // durationVE is the reflection-based (slow path) implementation for duration conversion.
 // It's used when fast path type assertions fail and more complex type analysis is needed.
-func durationVE(o any) (time.Duration, error) {
+func durationVE(c *Converter, o any) (time.Duration, error) {
 	// Get the underlying value, dereferencing pointers if necessary
 	v := indirectValue(reflect.ValueOf(o))
 
@@ -163,17 +190,18 @@ func durationVE(o any) (time.Duration, error) {
 	switch v.Kind() {
 	// Integer types: directly convert to duration (interpreted as nanoseconds)
 	case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
-		return time.Duration(v.Int()), nil
+		return signedFromInt[time.Duration](c, o, v.Int())
 	case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8:
-		return time.Duration(v.Uint()), nil
+		return signedFromUint[time.Duration](c, o, v.Uint())
 
-	// Floating point types: convert to duration (interpreted as nanoseconds)
+	// Floating point types: convert to duration (interpreted as nanoseconds) using the rounding
+	// and NaN and infinity policies of c
 	case reflect.Float64, reflect.Float32:
-		return time.Duration(v.Float()), nil
+		return signedFromFloat[time.Duration](c, o, v.Float())
 
 	// String conversion using time.ParseDuration
 	case reflect.String:
-		dur, err := time.ParseDuration(v.String())
+		dur, err := parseDuration(v.String())
 		if err != nil {
 			return failedCastErrValue[time.Duration](o, err)
 		}
@@ -185,14 +213,27 @@ func durationVE(o any) (time.Duration, error) {
 		if v.Type().Elem().Kind() != reflect.Uint8 {
 			return failedCastValue[time.Duration](o)
 		}
-		dur, err := time.ParseDuration(string(v.Bytes()))
+		dur, err := parseDuration(string(v.Bytes()))
 		if err != nil {
 			return failedCastErrValue[time.Duration](o, err)
 		}
 		return dur, nil
 
+	// Nil pointers that cannot be dereferenced
+	case reflect.Pointer:
+		return failedCastErrValue[time.Duration](o, ErrNil)
+
 	// Unsupported types
 	default:
 		return failedCastValue[time.Duration](o)
 	}
 }
+
+// parseDuration parses s with time.ParseDuration and marks parse failures as ErrSyntax.
+func parseDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrSyntax, err)
+	}
+	return d, nil
+}
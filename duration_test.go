@@ -1,6 +1,8 @@
 package gonv
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -25,4 +27,21 @@ func TestDurationE_Error(t *testing.T) {
 	if err == nil {
 		t.Fatalf("expected error for invalid duration string")
 	}
+
+	_, err = DurationE(1e300)
+	if !errors.Is(err, ErrOverflow) || strings.Count(err.Error(), "failed to cast") != 1 {
+		t.Fatalf("DurationE(1e300) = %v", err)
+	}
+}
+
+type testTimeout struct{ seconds int }
+
+func (t testTimeout) GonvDuration() (time.Duration, error) {
+	return time.Duration(t.seconds) * time.Second, nil
+}
+
+func TestDurationE_Durationer(t *testing.T) {
+	if d, err := DurationE(testTimeout{seconds: 5}); err != nil || d != 5*time.Second {
+		t.Fatalf("DurationE(Durationer) = %v, %v", d, err)
+	}
 }
@@ -1,32 +1,225 @@
 package gonv
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Sentinel errors classifying why a conversion failed.
+// A *CastError wraps one of them, so callers can test the failure class with errors.Is.
+//
+// Example:
+//
+//	_, err := UintE[uint]("-1")
+//	errors.Is(err, ErrNegative) // true
+var (
+	// ErrOverflow reports that the value does not fit in the target type.
+	ErrOverflow = errors.New("gonv: value out of range")
+	// ErrNegative reports that a negative value was converted to an unsigned type.
+	ErrNegative = errors.New("gonv: negative value")
+	// ErrSyntax reports that a string or byte slice could not be parsed as the target type.
+	ErrSyntax = errors.New("gonv: invalid syntax")
+	// ErrUnsupported reports that the type of the value cannot be converted to the target type.
+	ErrUnsupported = errors.New("gonv: unsupported type")
+	// ErrPrecision reports that the conversion would lose the fractional part of a floating-point value.
+	ErrPrecision = errors.New("gonv: loss of precision")
+	// ErrNonFinite reports that a NaN or infinite value was rejected.
+	ErrNonFinite = errors.New("gonv: NaN or infinite value")
+	// ErrNil reports that the value is a nil pointer that cannot be dereferenced.
+	ErrNil = errors.New("gonv: nil pointer")
+	// ErrCycle reports that a struct refers to itself through pointers, slices or maps and cannot be encoded.
+	ErrCycle = errors.New("gonv: cyclic value")
+)
 
 // Error message templates for failed type conversions
 var (
 	// failedCast is the error message template for conversion failures without underlying error
 	// Format: "gonv: failed to cast 'value' of type OriginalType to TargetType"
-	failedCast = "gonv: failed to cast %#v of type %T to %T"
+	failedCast = "gonv: failed to cast %#v of type %s to %s"
 
 	// failedCastErr is the error message template for conversion failures with underlying error
 	// Format: "gonv: failed to cast 'value' of type OriginalType to TargetType, underlying error"
-	failedCastErr = failedCast + ", %w"
+	failedCastErr = failedCast + ", %v"
+
+	// failedCastAt is the error message template for conversion failures of nested values without underlying error
+	// Format: "gonv: failed to cast 'value' of type OriginalType to TargetType at path"
+	failedCastAt = failedCast + " at %s"
+
+	// failedCastAtErr is the error message template for conversion failures of nested values with underlying error
+	// Format: "gonv: failed to cast 'value' of type OriginalType to TargetType at path, underlying error"
+	failedCastAtErr = failedCastAt + ", %v"
 )
 
-// failedCastValue creates a zero value of type E and returns it with a formatted error message.
-// Used when a type conversion fails without an underlying error.
+// CastError records a failed type conversion.
+// Every XxxE function in this package reports its failures as a *CastError,
+// so callers can inspect them with errors.As instead of matching error text.
+//
+// Example:
+//
+//	_, err := IntE[int]("not a number")
+//	var castErr *CastError
+//	if errors.As(err, &castErr) {
+//		// castErr.Value = "not a number", castErr.From = string, castErr.To = int
+//	}
+type CastError struct {
+	// Value is the value that could not be converted.
+	Value any
+	// From is the type of Value, or nil if Value is an untyped nil.
+	From reflect.Type
+	// To is the target type of the conversion.
+	To reflect.Type
+	// Err is the underlying cause of the failure, if any.
+	Err error
+	// Path locates Value within the input of a slice, map or struct conversion,
+	// such as `[4372]`, `["price"]` or `items[3].qty`. It is empty for top-level values.
+	Path string
+}
+
+// Error implements the error interface using the failedCast and failedCastErr templates,
+// or the failedCastAt and failedCastAtErr templates for nested values.
+func (e *CastError) Error() string {
+	if e.Path != "" {
+		if e.Err == nil {
+			return fmt.Sprintf(failedCastAt, e.Value, typeString(e.From), typeString(e.To), e.Path)
+		}
+		return fmt.Sprintf(failedCastAtErr, e.Value, typeString(e.From), typeString(e.To), e.Path, e.Err)
+	}
+	if e.Err == nil {
+		return fmt.Sprintf(failedCast, e.Value, typeString(e.From), typeString(e.To))
+	}
+	return fmt.Sprintf(failedCastErr, e.Value, typeString(e.From), typeString(e.To), e.Err)
+}
+
+// Unwrap returns the underlying cause of the failure.
+func (e *CastError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the failure class of the error.
+// Syntax and range errors from the strconv package are reported as ErrSyntax and ErrOverflow.
+func (e *CastError) Is(target error) bool {
+	switch target {
+	case ErrSyntax:
+		return errors.Is(e.Err, strconv.ErrSyntax)
+	case ErrOverflow:
+		return errors.Is(e.Err, strconv.ErrRange)
+	default:
+		return false
+	}
+}
+
+// ElementsError aggregates the failures of the elements of a slice or map conversion
+// under InvalidElementsCollect. Each failure is a *CastError whose Path locates the element,
+// and errors.Is and errors.As match any of them.
 //
 // Example:
 //
-//	var result, err = failedCastValue[int]("not a number")
-//	// result = 0, err = "gonv: failed to cast "not a number" of type string to int"
+//	_, err := IntSliceE[[]int]([]string{"1", "x", "y"}, WithInvalidElements(InvalidElementsCollect))
+//	var elemsErr *ElementsError
+//	if errors.As(err, &elemsErr) {
+//		// elemsErr.Keys = []any{1, 2}, elemsErr.Errs holds the failures at [1] and [2]
+//	}
+type ElementsError struct {
+	// From is the type of the slice, array or map being converted.
+	From reflect.Type
+	// To is the target type of the conversion.
+	To reflect.Type
+	// Keys lists the indexes of the failed slice elements or the keys of the failed map entries, in order.
+	Keys []any
+	// Errs lists the failures, including those of nested elements, in order.
+	Errs []error
+}
+
+// Error implements the error interface, listing every failure.
+func (e *ElementsError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "gonv: failed to cast %d elements of type %s to %s", len(e.Errs), typeString(e.From), typeString(e.To))
+	for i, err := range e.Errs {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the failures of the elements.
+func (e *ElementsError) Unwrap() []error {
+	return e.Errs
+}
+
+// elementErrors accumulates the failures of the elements of a slice or map conversion.
+type elementErrors struct {
+	keys []any
+	errs []error
+}
+
+// add records the failure err of the element at key.
+// The failures of a nested *ElementsError are recorded individually.
+func (e *elementErrors) add(key any, err error) {
+	e.keys = append(e.keys, key)
+	if elemsErr, ok := err.(*ElementsError); ok {
+		e.errs = append(e.errs, elemsErr.Errs...)
+		return
+	}
+	e.errs = append(e.errs, err)
+}
+
+// err returns an *ElementsError for the conversion of o to t with the recorded failures,
+// or nil if there are none.
+func (e *elementErrors) err(o any, t reflect.Type) error {
+	if len(e.errs) == 0 {
+		return nil
+	}
+	return &ElementsError{From: reflect.TypeOf(o), To: t, Keys: e.keys, Errs: e.errs}
+}
+
+// typeString returns the name of t, or "<nil>" if t is nil, matching the %T verb.
+func typeString(t reflect.Type) string {
+	if t == nil {
+		return "<nil>"
+	}
+	return t.String()
+}
+
+// typeOf returns the reflect.Type of E, including interface types such as any.
+func typeOf[E any]() reflect.Type {
+	return reflect.TypeOf((*E)(nil)).Elem()
+}
+
+// newCastError creates a *CastError describing the failed conversion of o to E.
+func newCastError[E any](o any, err error) *CastError {
+	return castError(o, typeOf[E](), err)
+}
+
+// castError creates a *CastError describing the failed conversion of o to the type t.
+func castError(o any, t reflect.Type, err error) *CastError {
+	return &CastError{
+		Value: o,
+		From:  reflect.TypeOf(o),
+		To:    t,
+		Err:   err,
+	}
+}
+
+// failedCastValue creates a zero value of type E and returns it with a *CastError wrapping ErrUnsupported.
+// Used when the type of o cannot be converted to E.
+//
+// Example:
+//
+//	var result, err = failedCastValue[int](struct{}{})
+//	// result = 0, err = "gonv: failed to cast struct {}{} of type struct {} to int, gonv: unsupported type"
 func failedCastValue[E any](o any) (E, error) {
-	var zero E
-	return zero, fmt.Errorf(failedCast, o, o, zero)
+	return failedCastErrValue[E](o, ErrUnsupported)
 }
 
-// failedCastErrValue creates a zero value of type E and returns it with a formatted error message
-// that includes the underlying error. Used when a type conversion fails with an underlying error.
+// failedCastErrValue creates a zero value of type E and returns it with a *CastError
+// that wraps the underlying error. Used when a type conversion fails with an underlying error.
 //
 // Example:
 //
@@ -34,5 +227,66 @@ func failedCastValue[E any](o any) (E, error) {
 //	// result = 0, err = "gonv: failed to cast "not a number" of type string to int, strconv.ErrSyntax"
 func failedCastErrValue[E any](o any, err error) (E, error) {
 	var zero E
-	return zero, fmt.Errorf(failedCastErr, o, o, zero, err)
+	return zero, newCastError[E](o, err)
+}
+
+// castErrValue creates a zero value of type E and returns it with err if err already is a *CastError,
+// or with a new *CastError wrapping err otherwise.
+// Used for errors returned by caller-supplied element and key converters.
+func castErrValue[E any](o any, err error) (E, error) {
+	var castErr *CastError
+	if errors.As(err, &castErr) {
+		var zero E
+		return zero, err
+	}
+	return failedCastErrValue[E](o, err)
+}
+
+// atPath returns err with segment prepended to its path if err is a *CastError,
+// err with segment prepended to the path of each failure if err is an *ElementsError, or err unchanged otherwise.
+// Segments are index segments such as `[3]` or `["price"]`, or field names such as `qty`,
+// which are joined with a dot when followed by another field name.
+//
+// Example:
+//
+//	err = atPath(atPath(atPath(err, "qty"), indexSegment(3)), "items") // err.Path = "items[3].qty"
+func atPath(err error, segment string) error {
+	if elemsErr, ok := err.(*ElementsError); ok {
+		r := *elemsErr
+		r.Errs = make([]error, len(elemsErr.Errs))
+		for i, err := range elemsErr.Errs {
+			r.Errs[i] = atPath(err, segment)
+		}
+		return &r
+	}
+	castErr, ok := err.(*CastError)
+	if !ok || castErr == nil {
+		return err
+	}
+	r := *castErr
+	switch {
+	case r.Path == "":
+		r.Path = segment
+	case strings.HasPrefix(r.Path, "["):
+		r.Path = segment + r.Path
+	default:
+		r.Path = segment + "." + r.Path
+	}
+	return &r
+}
+
+// indexSegment returns the path segment of the slice or array index i.
+func indexSegment(i int) string {
+	return "[" + strconv.Itoa(i) + "]"
+}
+
+// keySegment returns the path segment of the map key k, quoting string keys.
+func keySegment(k any) string {
+	if s, ok := k.(string); ok {
+		return "[" + strconv.Quote(s) + "]"
+	}
+	if v := reflect.ValueOf(k); v.Kind() == reflect.String {
+		return "[" + strconv.Quote(v.String()) + "]"
+	}
+	return fmt.Sprintf("[%v]", k)
 }
@@ -1,7 +1,131 @@
 package gonv
 
-import "testing"
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
 
 func TestErrorHelpersBuild(t *testing.T) {
 	// Ensure error.go compiles and any exported helpers are linkable.
 }
+
+func TestCastError(t *testing.T) {
+	_, err := IntE[int]("not a number")
+	var castErr *CastError
+	if !errors.As(err, &castErr) {
+		t.Fatalf("expected *CastError, got %T", err)
+	}
+	if castErr.Value != "not a number" {
+		t.Fatalf("unexpected value %#v", castErr.Value)
+	}
+	if castErr.From != reflect.TypeOf("") || castErr.To != reflect.TypeOf(0) {
+		t.Fatalf("unexpected types %v -> %v", castErr.From, castErr.To)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("expected cause to be strconv.ErrSyntax, got %v", castErr.Err)
+	}
+
+	_, err = AnySliceE(42)
+	if !errors.As(err, &castErr) || castErr.To != reflect.TypeOf([]any(nil)) {
+		t.Fatalf("expected *CastError to []interface {}, got %v", err)
+	}
+
+	_, err = IntSliceE[[]int]([]string{"1", "x"})
+	if !errors.As(err, &castErr) || castErr.Value != "x" {
+		t.Fatalf("expected element *CastError, got %v", err)
+	}
+}
+
+func TestSentinelErrors(t *testing.T) {
+	var nilInt *int
+	tests := []struct {
+		name   string
+		conv   func() error
+		target error
+	}{
+		{"int syntax", func() error { _, err := IntE[int]("abc"); return err }, ErrSyntax},
+		{"int overflow", func() error { _, err := IntE[int]("99999999999999999999"); return err }, ErrOverflow},
+		{"int unsupported", func() error { _, err := IntE[int](struct{}{}); return err }, ErrUnsupported},
+		{"int nil pointer", func() error { _, err := IntE[int](nilInt); return err }, ErrNil},
+		{"uint negative int", func() error { _, err := UintE[uint](-1); return err }, ErrNegative},
+		{"uint negative string", func() error { _, err := UintE[uint]("-1"); return err }, ErrNegative},
+		{"float syntax", func() error { _, err := FloatE[float64]("abc"); return err }, ErrSyntax},
+		{"bool syntax", func() error { _, err := BoolE[bool]("abc"); return err }, ErrSyntax},
+		{"duration syntax", func() error { _, err := DurationE("abc"); return err }, ErrSyntax},
+		{"time syntax", func() error { _, err := TimeE("abc"); return err }, ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.conv()
+			if !errors.Is(err, tt.target) {
+				t.Fatalf("expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+}
+
+func TestCastError_Path(t *testing.T) {
+	type item struct {
+		Qty int `json:"qty"`
+	}
+	type order struct {
+		Items []item `json:"items"`
+	}
+
+	ints := make([]string, 5000)
+	for i := range ints {
+		ints[i] = strconv.Itoa(i)
+	}
+	ints[4372] = "x"
+	tests := []struct {
+		name string
+		conv func() error
+		path string
+	}{
+		{"slice", func() error { _, err := IntSliceE[[]int](ints); return err }, "[4372]"},
+		{"map", func() error { _, err := StringFloatMapE[string, float64](map[string]any{"price": "x"}); return err }, `["price"]`},
+		{"int key", func() error { _, err := To[map[int]int](map[int]string{7: "x"}); return err }, "[7]"},
+		{"nested slice", func() error { _, err := To[[][]int]([][]string{{"1"}, {"2", "x"}}); return err }, "[1][1]"},
+		{"struct", func() error {
+			_, err := StructE[order](map[string]any{"items": []any{map[string]any{}, map[string]any{}, map[string]any{}, map[string]any{"qty": "x"}}})
+			return err
+		}, "items[3].qty"},
+		{"slice of structs", func() error {
+			_, err := To[[]order]([]any{map[string]any{"items": []any{map[string]any{"qty": "x"}}}})
+			return err
+		}, "[0].items[0].qty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.conv()
+			var castErr *CastError
+			if !errors.As(err, &castErr) || castErr.Path != tt.path || castErr.Value != "x" {
+				t.Fatalf("expected path %s, got %v", tt.path, err)
+			}
+			if !strings.Contains(err.Error(), " at "+tt.path+", ") || !errors.Is(err, ErrSyntax) {
+				t.Fatalf("unexpected message %q", err)
+			}
+		})
+	}
+}
+
+func TestCastError_ElementType(t *testing.T) {
+	plain := errors.New("plain")
+	_, err := SliceE[[]int]([]string{"1"}, func(any) (int, error) { return 0, plain })
+	var castErr *CastError
+	if !errors.As(err, &castErr) || castErr.To != reflect.TypeOf(0) || castErr.Path != "[0]" || !errors.Is(err, plain) {
+		t.Errorf("SliceE() = %v", err)
+	}
+
+	_, err = MapE[map[string]int](map[string]string{"a": "1"}, func(any) (string, error) { return "", plain }, IntE[int])
+	if !errors.As(err, &castErr) || castErr.To != reflect.TypeOf("") {
+		t.Errorf("MapE(key) = %v", err)
+	}
+	_, err = MapE[map[string]int](map[string]string{"a": "1"}, StringE[string], func(any) (int, error) { return 0, plain })
+	if !errors.As(err, &castErr) || castErr.To != reflect.TypeOf(0) || castErr.Path != `["a"]` {
+		t.Errorf("MapE(value) = %v", err)
+	}
+}
@@ -3,7 +3,9 @@ package gonv
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"time"
@@ -13,6 +15,21 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// Floater is implemented by types that can convert themselves to a floating-point number.
+// The floating-point converter calls GonvFloat64 before falling back to
+// driver.Valuer, fmt.Stringer and reflection.
+//
+// Example:
+//
+//	type Money struct{ cents int64 }
+//
+//	func (m Money) GonvFloat64() (float64, error) { return float64(m.cents) / 100, nil }
+//
+//	result := Float[float64](Money{cents: 1234}) // returns 12.34
+type Floater interface {
+	GonvFloat64() (float64, error)
+}
+
 // Float converts an interface to a floating-point type, ignoring any conversion errors.
 // It returns the zero value of the target type if conversion fails.
 // E must be a floating-point type (float32 or float64).
@@ -36,7 +53,7 @@ func Float[E constraints.Float](o any) E {
 //	result, err := FloatE[float64]("3.14") // returns 3.14, nil
 //	result, err := FloatE[float64]("invalid") // returns 0.0, error
 func FloatE[E constraints.Float](o any) (E, error) {
-	return floatE[E](o)
+	return floatE[E](defaultConverter, o)
 }
 
 // FloatS converts an interface to a floating-point slice type, ignoring any conversion errors.
@@ -47,8 +64,8 @@ func FloatE[E constraints.Float](o any) (E, error) {
 // Example:
 //
 //	result := FloatS[[]float64]([]string{"1.1", "2.2", "3.3"}) // returns []float64{1.1, 2.2, 3.3}
-func FloatS[S ~[]E, E constraints.Float](o any) S {
-	v, _ := FloatSE[S](o)
+func FloatS[S ~[]E, E constraints.Float](o any, opts ...Option) S {
+	v, _ := FloatSE[S](o, opts...)
 	return v
 }
 
@@ -56,39 +73,49 @@ func FloatS[S ~[]E, E constraints.Float](o any) S {
 // This function is useful when you need to handle conversion errors for slice data explicitly.
 // S is a slice type with elements of floating-point type.
 // E must be a floating-point type (float32 or float64).
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := FloatSE[[]float64]([]string{"1.1", "2.2"}) // returns []float64{1.1, 2.2}, nil
 //	result, err := FloatSE[[]float64]([]string{"1.1", "invalid"}) // returns nil, error
-func FloatSE[S ~[]E, E constraints.Float](o any) (S, error) {
-	return toSliceE[S](o, floatE[E])
+func FloatSE[S ~[]E, E constraints.Float](o any, opts ...Option) (S, error) {
+	c := converterWith(opts)
+	return toSliceE[S](c, o, bind(c, floatE[E]))
 }
 
 // floatE is the core implementation of floating-point conversion with error handling.
 // It uses a fast path approach for common types and falls back to reflection for complex types.
 // E must be a floating-point type (float32 or float64).
-func floatE[E constraints.Float](o any) (E, error) {
+func floatE[E constraints.Float](c *Converter, o any) (E, error) {
 	var zero E
 	// Handle nil input by returning zero value
 	if o == nil {
 		return zero, nil
 	}
 
+	// Registered conversions take precedence over the built-in ones
+	if r, ok, err := registered[E](o); ok {
+		return r, err
+	}
+
 	// Fast path: direct type assertions for common types
 	switch f := o.(type) {
-	// Boolean conversion: true becomes 1.0, false becomes 0.0
+	// Boolean conversion: true becomes 1.0, false becomes 0.0; rejected by strict converters
 	case bool:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		if f {
 			return 1, nil
 		}
 		return zero, nil
 
-	// Native floating-point types
+	// Native floating-point types, subject to the NaN and infinity policy
 	case float64:
-		return E(f), nil
+		return floatFromFloat[E](c, o, f)
 	case float32:
-		return E(f), nil
+		return floatFromFloat[E](c, o, float64(f))
 
 	// Integer types: direct conversion to floating-point
 	case int:
@@ -114,27 +141,15 @@ func floatE[E constraints.Float](o any) (E, error) {
 
 	// String conversion using strconv.ParseFloat
 	case string:
-		v, err := strconv.ParseFloat(f, 64)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseFloat[E](c, o, f)
 
 	// Byte slice conversion by converting to string first
 	case []byte:
-		v, err := strconv.ParseFloat(string(f), 64)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseFloat[E](c, o, string(f))
 
 	// JSON number support
 	case json.Number:
-		v, err := f.Float64()
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseFloat[E](c, o, f.String())
 
 	// Time types that can be converted to numeric values
 	case time.Weekday:
@@ -144,13 +159,21 @@ func floatE[E constraints.Float](o any) (E, error) {
 	case time.Duration:
 		return E(f), nil
 
+	// Floater interface support for types that convert themselves to floating-point numbers
+	case Floater:
+		v, err := f.GonvFloat64()
+		if err != nil {
+			return failedCastErrValue[E](o, err)
+		}
+		return floatFromFloat[E](c, o, v)
+
 	// Database driver.Valuer interface support
 	case driver.Valuer:
 		v, err := f.Value()
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
-		r, err := floatE[E](v)
+		r, err := floatE[E](c, v)
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
@@ -162,14 +185,17 @@ func floatE[E constraints.Float](o any) (E, error) {
 
 	// Protobuf wrapper types support
 	case *wrapperspb.BoolValue:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		if f.GetValue() {
 			return 1, nil
 		}
 		return zero, nil
 	case *wrapperspb.DoubleValue:
-		return E(f.GetValue()), nil
+		return floatFromFloat[E](c, o, f.GetValue())
 	case *wrapperspb.FloatValue:
-		return E(f.GetValue()), nil
+		return floatFromFloat[E](c, o, float64(f.GetValue()))
 	case *wrapperspb.Int64Value:
 		return E(f.GetValue()), nil
 	case *wrapperspb.Int32Value:
@@ -179,45 +205,36 @@ func floatE[E constraints.Float](o any) (E, error) {
 	case *wrapperspb.UInt32Value:
 		return E(f.GetValue()), nil
 	case *wrapperspb.StringValue:
-		v, err := strconv.ParseFloat(f.GetValue(), 64)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseFloat[E](c, o, f.GetValue())
 	case *wrapperspb.BytesValue:
-		v, err := strconv.ParseFloat(string(f.GetValue()), 64)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseFloat[E](c, o, string(f.GetValue()))
 
 	// Stringer interface support for custom types that can be represented as strings
 	case fmt.Stringer:
-		v, err := strconv.ParseFloat(f.String(), 64)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseFloat[E](c, o, f.String())
 
 	// Default case: use reflection-based conversion for complex types
 	default:
 		// slow path
-		return floatVE[E](o)
+		return floatVE[E](c, o)
 	}
 }
 
 // floatVE is the reflection-based (slow path) implementation for floating-point conversion.
 // It's used when fast path type assertions fail and more complex type analysis is needed.
 // E must be a floating-point type (float32 or float64).
-func floatVE[E constraints.Float](o any) (E, error) {
+func floatVE[E constraints.Float](c *Converter, o any) (E, error) {
 	var zero E
 	// Get the underlying value, dereferencing pointers if necessary
 	v := indirectValue(reflect.ValueOf(o))
 
 	// Handle different reflection kinds
 	switch v.Kind() {
-	// Boolean conversion: true becomes 1.0, false becomes 0.0
+	// Boolean conversion: true becomes 1.0, false becomes 0.0; rejected by strict converters
 	case reflect.Bool:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		if v.Bool() {
 			return 1, nil
 		}
@@ -229,17 +246,13 @@ func floatVE[E constraints.Float](o any) (E, error) {
 	case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8:
 		return E(v.Uint()), nil
 
-	// Floating-point types: direct conversion
+	// Floating-point types, subject to the NaN and infinity policy
 	case reflect.Float64, reflect.Float32:
-		return E(v.Float()), nil
+		return floatFromFloat[E](c, o, v.Float())
 
 	// String conversion using strconv.ParseFloat
 	case reflect.String:
-		f, err := strconv.ParseFloat(v.String(), 64)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(f), nil
+		return parseFloat[E](c, o, v.String())
 
 	// Byte slice conversion (must be []byte)
 	case reflect.Slice:
@@ -247,14 +260,56 @@ func floatVE[E constraints.Float](o any) (E, error) {
 		if v.Type().Elem().Kind() != reflect.Uint8 {
 			return failedCastValue[E](o)
 		}
-		f, err := strconv.ParseFloat(string(v.Bytes()), 64)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(f), nil
+		return parseFloat[E](c, o, string(v.Bytes()))
+
+	// Nil pointers that cannot be dereferenced
+	case reflect.Pointer:
+		return failedCastErrValue[E](o, ErrNil)
 
 	// Unsupported types
 	default:
 		return failedCastValue[E](o)
 	}
 }
+
+// floatFromFloat converts the float64 value v, taken from o, to E.
+// NaN and infinite values are handled by the NaN and infinity policy of c;
+// an error wrapping ErrNonFinite is returned if the policy rejects them.
+// Finite values beyond the range of E, such as 1e39 for float32, are handled by the overflow policy of c.
+func floatFromFloat[E constraints.Float](c *Converter, o any, v float64) (E, error) {
+	if !isFinite(v) {
+		sign, ok := c.nonFiniteSign(v)
+		if !ok {
+			return failedCastErrValue[E](o, ErrNonFinite)
+		}
+		return E(float64(sign) * maxFloat[E]()), nil
+	}
+	r := E(v)
+	if math.IsInf(float64(r), 0) {
+		return floatOverflow[E](c, o, v, ErrOverflow)
+	}
+	return r, nil
+}
+
+// floatOverflow applies the overflow policy of c to the finite value v, taken from o, that does not fit in E.
+// Saturation yields the largest or smallest value of E; the other policies reject v with err.
+func floatOverflow[E constraints.Float](c *Converter, o any, v float64, err error) (E, error) {
+	if c.overflow == OverflowSaturate {
+		return E(math.Copysign(maxFloat[E](), v)), nil
+	}
+	return failedCastErrValue[E](o, err)
+}
+
+// parseFloat parses the string s, taken from o, with strconv.ParseFloat and converts the result to E.
+// "NaN" and "Inf" are handled by the NaN and infinity policy of c,
+// and numbers beyond the range of E by its overflow policy.
+func parseFloat[E constraints.Float](c *Converter, o any, s string) (E, error) {
+	v, err := strconv.ParseFloat(s, bitSize[E]())
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) && math.IsInf(v, 0) {
+			return floatOverflow[E](c, o, v, err)
+		}
+		return failedCastErrValue[E](o, err)
+	}
+	return floatFromFloat[E](c, o, v)
+}
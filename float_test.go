@@ -2,6 +2,7 @@ package gonv
 
 import (
 	"errors"
+	"math"
 	"strings"
 	"testing"
 
@@ -105,3 +106,35 @@ func TestToFloatE(t *testing.T) {
 		})
 	}
 }
+
+type testPrice struct{ cents int64 }
+
+func (p testPrice) GonvFloat64() (float64, error) { return float64(p.cents) / 100, nil }
+
+func TestFloatE_Floater(t *testing.T) {
+	v, err := FloatE[float64](testPrice{cents: 1234})
+	require.NoError(t, err)
+	assert.Equal(t, 12.34, v)
+}
+
+func TestFloatE_Range(t *testing.T) {
+	for _, in := range []any{1e39, -1e39, "1e39", float64(math.MaxFloat64)} {
+		if v, err := FloatE[float32](in); !errors.Is(err, ErrOverflow) {
+			t.Errorf("FloatE[float32](%v) = %v, %v, want ErrOverflow", in, v, err)
+		}
+	}
+	if v, err := FloatE[float64]("1e400"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("FloatE[float64](\"1e400\") = %v, %v, want ErrOverflow", v, err)
+	}
+
+	saturate := New(WithOverflow(OverflowSaturate))
+	if v, err := saturate.Float32E(1e39); err != nil || v != math.MaxFloat32 {
+		t.Errorf("saturate Float32E(1e39) = %v, %v", v, err)
+	}
+	if v, err := saturate.Float32E("-1e39"); err != nil || v != -math.MaxFloat32 {
+		t.Errorf("saturate Float32E(\"-1e39\") = %v, %v", v, err)
+	}
+	if v, err := FloatE[float32](3.4e38); err != nil || v != float32(3.4e38) {
+		t.Errorf("FloatE[float32](3.4e38) = %v, %v", v, err)
+	}
+}
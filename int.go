@@ -3,7 +3,9 @@ package gonv
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"time"
@@ -14,6 +16,21 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// Inter is implemented by types that can convert themselves to an integer.
+// The integer and unsigned integer converters call GonvInt64 before falling back to
+// driver.Valuer, fmt.Stringer and reflection.
+//
+// Example:
+//
+//	type UserID struct{ id int64 }
+//
+//	func (u UserID) GonvInt64() (int64, error) { return u.id, nil }
+//
+//	result := Int[int64](UserID{id: 42}) // returns 42
+type Inter interface {
+	GonvInt64() (int64, error)
+}
+
 // Int converts an interface to a signed integer type, ignoring any conversion errors.
 // It returns the zero value of the target type if conversion fails.
 // E must be a signed integer type (int, int8, int16, int32, int64).
@@ -23,6 +40,7 @@ import (
 //	result := Int[int64]("42") // returns 42
 //	result := Int[int](true) // returns 1
 //	result := Int[int](3.14) // returns 3
+//	result := Int[int]("2.5") // returns 0, fractional strings are only rounded with WithRounding
 func Int[E constraints.Signed](o any) E {
 	v, _ := IntE[E](o)
 	return v
@@ -36,8 +54,10 @@ func Int[E constraints.Signed](o any) E {
 //
 //	result, err := IntE[int64]("42") // returns 42, nil
 //	result, err := IntE[int64]("invalid") // returns 0, error
+//	result, err := IntE[int8](300) // returns 0, error (value out of range of int8)
+//	result, err := IntE[int]("19.99") // returns 0, error (fractional strings are only rounded with WithRounding)
 func IntE[E constraints.Signed](o any) (E, error) {
-	return intE[E](o)
+	return intE[E](defaultConverter, o)
 }
 
 // IntS converts an interface to a signed integer slice type, ignoring any conversion errors.
@@ -48,8 +68,8 @@ func IntE[E constraints.Signed](o any) (E, error) {
 // Example:
 //
 //	result := IntS[[]int64]([]string{"1", "2", "3"}) // returns []int64{1, 2, 3}
-func IntS[S ~[]E, E constraints.Signed](o any) S {
-	v, _ := IntSE[S](o)
+func IntS[S ~[]E, E constraints.Signed](o any, opts ...Option) S {
+	v, _ := IntSE[S](o, opts...)
 	return v
 }
 
@@ -57,132 +77,133 @@ func IntS[S ~[]E, E constraints.Signed](o any) S {
 // This function is useful when you need to handle conversion errors for slice data explicitly.
 // S is a slice type with elements of signed integer type.
 // E must be a signed integer type (int, int8, int16, int32, int64).
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := IntSE[[]int64]([]string{"1", "2"}) // returns []int64{1, 2}, nil
 //	result, err := IntSE[[]int64]([]string{"1", "invalid"}) // returns nil, error
-func IntSE[S ~[]E, E constraints.Signed](o any) (S, error) {
-	return toSliceE[S](o, IntE[E])
+func IntSE[S ~[]E, E constraints.Signed](o any, opts ...Option) (S, error) {
+	c := converterWith(opts)
+	return toSliceE[S](c, o, bind(c, intE[E]))
 }
 
 // intE is the core implementation of signed integer conversion with error handling.
 // It uses a fast path approach for common types and falls back to reflection for complex types.
 // E must be a signed integer type (int, int8, int16, int32, int64).
-func intE[E constraints.Signed](o any) (E, error) {
+func intE[E constraints.Signed](c *Converter, o any) (E, error) {
 	var zero E
 	// Handle nil input by returning zero value
 	if o == nil {
 		return zero, nil
 	}
 
+	// Registered conversions take precedence over the built-in ones
+	if r, ok, err := registered[E](o); ok {
+		return r, err
+	}
+
 	// Fast path: direct type assertions for common types
 	switch s := o.(type) {
-	// Boolean conversion: true becomes 1, false becomes 0
+	// Boolean conversion: true becomes 1, false becomes 0; rejected by strict converters
 	case bool:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		if s {
 			return 1, nil
 		}
 		return zero, nil
 
-	// Floating-point types: direct conversion to integer (truncates decimal part)
+	// Floating-point types: conversion to integer (truncates decimal part), checked against the range of E
 	case float64:
-		return E(s), nil
+		return signedFromFloat[E](c, o, s)
 	case float32:
-		return E(s), nil
+		return signedFromFloat[E](c, o, float64(s))
 
-	// Integer types: direct conversion
+	// Integer types: conversion checked against the range of E
 	case int:
-		return E(s), nil
+		return signedFromInt[E](c, o, int64(s))
 	case int64:
-		return E(s), nil
+		return signedFromInt[E](c, o, s)
 	case int32:
-		return E(s), nil
+		return signedFromInt[E](c, o, int64(s))
 	case int16:
-		return E(s), nil
+		return signedFromInt[E](c, o, int64(s))
 	case int8:
-		return E(s), nil
+		return signedFromInt[E](c, o, int64(s))
 	case uint:
-		return E(s), nil
+		return signedFromUint[E](c, o, uint64(s))
 	case uint64:
-		return E(s), nil
+		return signedFromUint[E](c, o, s)
 	case uint32:
-		return E(s), nil
+		return signedFromUint[E](c, o, uint64(s))
 	case uint16:
-		return E(s), nil
+		return signedFromUint[E](c, o, uint64(s))
 	case uint8:
-		return E(s), nil
+		return signedFromUint[E](c, o, uint64(s))
 
 	// String conversion using strconv.ParseInt with trimZeroDecimal
 	case string:
-		v, err := strconv.ParseInt(trimZeroDecimal(s), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseSigned[E](c, o, s)
 
 	// Byte slice conversion by converting to string first
 	case []byte:
-		v, err := strconv.ParseInt(trimZeroDecimal(string(s)), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseSigned[E](c, o, string(s))
 
 	// JSON number support
 	case json.Number:
-		v, err := s.Int64()
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseSigned[E](c, o, s.String())
 
 	// Time types that can be converted to numeric values
 	case time.Weekday:
-		return E(s), nil
+		return signedFromInt[E](c, o, int64(s))
 	case time.Month:
-		return E(s), nil
+		return signedFromInt[E](c, o, int64(s))
 	case time.Duration:
-		return E(s), nil
+		return signedFromInt[E](c, o, int64(s))
 
 	// Protobuf duration type support: convert to duration then to integer
 	case *durationpb.Duration:
-		return E(s.AsDuration()), nil
+		return signedFromInt[E](c, o, int64(s.AsDuration()))
 
 	// Protobuf timestamp type support: convert to milliseconds since Unix epoch
 	case *timestamppb.Timestamp:
-		return E(s.AsTime().UnixMilli()), nil
+		return signedFromInt[E](c, o, s.AsTime().UnixMilli())
 
 	// Protobuf wrapper types support
 	case *wrapperspb.BoolValue:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		if s.GetValue() {
 			return 1, nil
 		}
 		return zero, nil
 	case *wrapperspb.DoubleValue:
-		return E(s.GetValue()), nil
+		return signedFromFloat[E](c, o, s.GetValue())
 	case *wrapperspb.FloatValue:
-		return E(s.GetValue()), nil
+		return signedFromFloat[E](c, o, float64(s.GetValue()))
 	case *wrapperspb.Int64Value:
-		return E(s.GetValue()), nil
+		return signedFromInt[E](c, o, s.GetValue())
 	case *wrapperspb.Int32Value:
-		return E(s.GetValue()), nil
+		return signedFromInt[E](c, o, int64(s.GetValue()))
 	case *wrapperspb.UInt64Value:
-		return E(s.GetValue()), nil
+		return signedFromUint[E](c, o, s.GetValue())
 	case *wrapperspb.UInt32Value:
-		return E(s.GetValue()), nil
+		return signedFromUint[E](c, o, uint64(s.GetValue()))
 	case *wrapperspb.StringValue:
-		i, err := strconv.ParseInt(trimZeroDecimal(s.GetValue()), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(i), nil
+		return parseSigned[E](c, o, s.GetValue())
 	case *wrapperspb.BytesValue:
-		i, err := strconv.ParseInt(trimZeroDecimal(string(s.GetValue())), 0, 0)
+		return parseSigned[E](c, o, string(s.GetValue()))
+
+	// Inter interface support for types that convert themselves to integers
+	case Inter:
+		v, err := s.GonvInt64()
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
-		return E(i), nil
+		return signedFromInt[E](c, o, v)
 
 	// Database driver.Valuer interface support
 	case driver.Valuer:
@@ -190,7 +211,7 @@ func intE[E constraints.Signed](o any) (E, error) {
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
-		r, err := intE[E](v)
+		r, err := intE[E](c, v)
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
@@ -198,53 +219,48 @@ func intE[E constraints.Signed](o any) (E, error) {
 
 	// Stringer interface support for custom types that can be represented as strings
 	case fmt.Stringer:
-		v, err := strconv.ParseInt(trimZeroDecimal(s.String()), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseSigned[E](c, o, s.String())
 
 	// Default case: use reflection-based conversion for complex types
 	default:
 		// slow path
-		return toSignedValueE[E](o)
+		return toSignedValueE[E](c, o)
 	}
 }
 
 // toSignedValueE is the reflection-based (slow path) implementation for signed integer conversion.
 // It's used when fast path type assertions fail and more complex type analysis is needed.
 // E must be a signed integer type (int, int8, int16, int32, int64).
-func toSignedValueE[E constraints.Signed](o any) (E, error) {
+func toSignedValueE[E constraints.Signed](c *Converter, o any) (E, error) {
 	var zero E
 	// Get the underlying value, dereferencing pointers if necessary
 	v := indirectValue(reflect.ValueOf(o))
 
 	// Handle different reflection kinds
 	switch v.Kind() {
-	// Boolean conversion: true becomes 1, false becomes 0
+	// Boolean conversion: true becomes 1, false becomes 0; rejected by strict converters
 	case reflect.Bool:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		if v.Bool() {
 			return 1, nil
 		}
 		return zero, nil
 
-	// Integer types: direct conversion
+	// Integer types: conversion checked against the range of E
 	case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
-		return E(v.Int()), nil
+		return signedFromInt[E](c, o, v.Int())
 	case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8:
-		return E(v.Uint()), nil
+		return signedFromUint[E](c, o, v.Uint())
 
-	// Floating-point types: conversion to integer (truncates decimal part)
+	// Floating-point types: conversion to integer (truncates decimal part), checked against the range of E
 	case reflect.Float64, reflect.Float32:
-		return E(v.Float()), nil
+		return signedFromFloat[E](c, o, v.Float())
 
 	// String conversion using strconv.ParseInt with trimZeroDecimal
 	case reflect.String:
-		i, err := strconv.ParseInt(trimZeroDecimal(v.String()), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(i), nil
+		return parseSigned[E](c, o, v.String())
 
 	// Byte slice conversion (must be []byte)
 	case reflect.Slice:
@@ -252,14 +268,122 @@ func toSignedValueE[E constraints.Signed](o any) (E, error) {
 		if v.Type().Elem().Kind() != reflect.Uint8 {
 			return failedCastValue[E](o)
 		}
-		i, err := strconv.ParseInt(trimZeroDecimal(string(v.Bytes())), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(i), nil
+		return parseSigned[E](c, o, string(v.Bytes()))
+
+	// Nil pointers that cannot be dereferenced
+	case reflect.Pointer:
+		return failedCastErrValue[E](o, ErrNil)
 
 	// Unsupported types
 	default:
 		return failedCastValue[E](o)
 	}
 }
+
+// signedFromInt converts the int64 value v, taken from o, to E.
+// Values that do not fit in E are handled by the overflow policy of c;
+// an error wrapping ErrOverflow is returned if the policy rejects them.
+func signedFromInt[E constraints.Signed](c *Converter, o any, v int64) (E, error) {
+	switch {
+	case v > maxSigned[E]():
+		return signedOverflow[E](c, o, v, 1)
+	case v < minSigned[E]():
+		return signedOverflow[E](c, o, v, -1)
+	default:
+		return E(v), nil
+	}
+}
+
+// signedFromUint converts the uint64 value v, taken from o, to E.
+// Values that do not fit in E are handled by the overflow policy of c;
+// an error wrapping ErrOverflow is returned if the policy rejects them.
+func signedFromUint[E constraints.Signed](c *Converter, o any, v uint64) (E, error) {
+	if v > uint64(maxSigned[E]()) {
+		return signedOverflow[E](c, o, int64(v), 1)
+	}
+	return E(v), nil
+}
+
+// signedFromFloat converts the float64 value v, taken from o, to E using the rounding policy of c.
+// NaN and infinite values are handled by the NaN and infinity policy of c,
+// and values that do not fit in E by the overflow policy of c.
+// It returns an error wrapping ErrNonFinite, ErrPrecision or ErrOverflow if a policy rejects v.
+func signedFromFloat[E constraints.Signed](c *Converter, o any, v float64) (E, error) {
+	if !isFinite(v) {
+		sign, ok := c.nonFiniteSign(v)
+		switch {
+		case !ok:
+			return failedCastErrValue[E](o, ErrNonFinite)
+		case sign > 0:
+			return E(maxSigned[E]()), nil
+		case sign < 0:
+			return E(minSigned[E]()), nil
+		default:
+			return 0, nil
+		}
+	}
+	r, exact := c.round(v)
+	if !exact {
+		return failedCastErrValue[E](o, ErrPrecision)
+	}
+	// -2^(n-1) is the smallest n-bit signed integer and 2^(n-1) the first value past the largest,
+	// both are exact in float64.
+	limit := math.Ldexp(1, bitSize[E]()-1)
+	switch {
+	case r >= limit:
+		return signedOverflow[E](c, o, wrapFloat(r), 1)
+	case r < -limit:
+		return signedOverflow[E](c, o, wrapFloat(r), -1)
+	default:
+		return E(r), nil
+	}
+}
+
+// signedOverflow applies the overflow policy of c to the value v, taken from o, that does not fit in E.
+// sign is 1 if v is above the range of E and -1 if it is below.
+// v holds the bits that are truncated to E when the policy wraps around.
+func signedOverflow[E constraints.Signed](c *Converter, o any, v int64, sign int) (E, error) {
+	switch c.overflow {
+	case OverflowWrap:
+		return E(v), nil
+	case OverflowSaturate:
+		if sign > 0 {
+			return E(maxSigned[E]()), nil
+		}
+		return E(minSigned[E]()), nil
+	default:
+		return failedCastErrValue[E](o, ErrOverflow)
+	}
+}
+
+// parseSigned parses the string s, taken from o, as a signed integer in the base of c and converts it to E.
+// Strings that only parse as floating-point numbers, such as "2.5", are rounded using the policy of c
+// if it was set with WithRounding, and rejected otherwise unless they are integral, such as "1e3".
+// Numbers that do not fit in E are handled by the overflow policy of c.
+func parseSigned[E constraints.Signed](c *Converter, o any, s string) (E, error) {
+	v, err := strconv.ParseInt(trimZeroDecimal(s), c.base, 64)
+	switch {
+	case err == nil:
+		return signedFromInt[E](c, o, v)
+	case errors.Is(err, strconv.ErrRange) && c.overflow == OverflowSaturate:
+		// ParseInt returns the nearest int64 on range errors.
+		return signedFromInt[E](c, o, v)
+	case errors.Is(err, strconv.ErrRange) && c.overflow == OverflowWrap:
+		if bits, ok := wrapString(trimZeroDecimal(s), c.base); ok {
+			return signedFromInt[E](c, o, int64(bits))
+		}
+	case errors.Is(err, strconv.ErrSyntax):
+		f, ferr := strconv.ParseFloat(s, 64)
+		switch {
+		case ferr == nil && (c.rounded || f == math.Trunc(f)):
+			return signedFromFloat[E](c, o, f)
+		case errors.Is(ferr, strconv.ErrRange) && math.IsInf(f, 0):
+			// Numbers beyond the range of float64, such as "1e400", are beyond the range of E too
+			if c.overflow == OverflowSaturate {
+				return signedFromFloat[E](c, o, math.Copysign(math.MaxFloat64, f))
+			}
+			err = ferr
+		}
+	}
+	return failedCastErrValue[E](o, err)
+}
@@ -1,6 +1,8 @@
 package gonv
 
 import (
+	"errors"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -108,3 +110,65 @@ func TestToSignedSliceE(t *testing.T) {
 		})
 	}
 }
+
+func TestIntE_Range(t *testing.T) {
+	tests := []struct {
+		name    string
+		conv    func() (any, error)
+		want    any
+		wantErr bool
+	}{
+		{"int to int8 in range", func() (any, error) { return IntE[int8](127) }, int8(127), false},
+		{"int to int8 overflow", func() (any, error) { return IntE[int8](300) }, int8(0), true},
+		{"int to int8 underflow", func() (any, error) { return IntE[int8](-129) }, int8(0), true},
+		{"string to int8 overflow", func() (any, error) { return IntE[int8]("300") }, int8(0), true},
+		{"uint64 to int64 overflow", func() (any, error) { return IntE[int64](uint64(1 << 63)) }, int64(0), true},
+		{"float to int32 overflow", func() (any, error) { return IntE[int32](3e9) }, int32(0), true},
+		{"float to int64 min", func() (any, error) { return IntE[int64](float64(-1 << 63)) }, int64(-1 << 63), false},
+		{"reflect int to int16 overflow", func() (any, error) { return IntE[int16](namedInt(100000)) }, int16(0), true},
+		{"uint to uint8 overflow", func() (any, error) { return UintE[uint8](256) }, uint8(0), true},
+		{"string to uint16 overflow", func() (any, error) { return UintE[uint16]("70000") }, uint16(0), true},
+		{"float to uint8 in range", func() (any, error) { return UintE[uint8](255.9) }, uint8(255), false},
+		{"reflect uint to uint8 overflow", func() (any, error) { return UintE[uint8](namedUint(256)) }, uint8(0), true},
+		{"float string to int beyond float64", func() (any, error) { return IntE[int]("1e400") }, 0, true},
+		{"float string to int below float64", func() (any, error) { return IntE[int]("-1e400") }, 0, true},
+		{"float string to uint beyond float64", func() (any, error) { return UintE[uint]("1e400") }, uint(0), true},
+		{"saturated float string beyond float64", func() (any, error) { return New(WithOverflow(OverflowSaturate)).Int64E("1e400") }, int64(math.MaxInt64), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.conv()
+			if tt.wantErr {
+				if !errors.Is(err, ErrOverflow) {
+					t.Fatalf("expected ErrOverflow, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type (
+	namedInt  int
+	namedUint uint
+)
+
+type testUserID struct{ id int64 }
+
+func (u testUserID) GonvInt64() (int64, error) { return u.id, nil }
+
+func TestIntE_Inter(t *testing.T) {
+	if v, err := IntE[int32](testUserID{id: 42}); err != nil || v != 42 {
+		t.Fatalf("IntE(Inter) = %v, %v", v, err)
+	}
+	if _, err := IntE[int8](testUserID{id: 1000}); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected ErrOverflow, got %v", err)
+	}
+	if _, err := UintE[uint](testUserID{id: -1}); !errors.Is(err, ErrNegative) {
+		t.Fatalf("expected ErrNegative, got %v", err)
+	}
+}
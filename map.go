@@ -2,7 +2,11 @@ package gonv
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
 	"reflect"
+	"sort"
 
 	"golang.org/x/exp/constraints"
 )
@@ -15,21 +19,24 @@ import (
 //
 //	result := StringAnyMap[string](map[string]interface{}{"key": "value"}) // returns map[string]interface{}{"key": "value"}
 //	result := StringAnyMap[string](`{"key": "value"}`) // returns map[string]interface{}{"key": "value"}
-func StringAnyMap[K ~string](o any) map[K]any {
-	v, _ := StringAnyMapE[K](o)
+func StringAnyMap[K ~string](o any, opts ...Option) map[K]any {
+	v, _ := StringAnyMapE[K](o, opts...)
 	return v
 }
 
 // StringAnyMapE casts an interface to a map[string]any type, returning both the converted map and any error encountered.
 // This function is useful when you need to handle conversion errors explicitly.
 // K must be a string type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := StringAnyMapE[string](map[string]interface{}{"key": "value"}) // returns map[string]interface{}{"key": "value"}, nil
+//	result, err := StringAnyMapE[string](struct{ Name string `json:"name"` }{"gopher"}) // returns map[string]interface{}{"name": "gopher"}, nil
 //	result, err := StringAnyMapE[string]("invalid") // returns nil, error
-func StringAnyMapE[K ~string](o any) (map[K]any, error) {
-	return mapE[map[K]any](o, StringE[K], func(o any) (any, error) { return o, nil })
+func StringAnyMapE[K ~string](o any, opts ...Option) (map[K]any, error) {
+	c := converterWith(opts)
+	return mapE[map[K]any](c, o, bind(c, stringE[K]), func(o any) (any, error) { return o, nil })
 }
 
 // StringStringMap casts an interface to a map[string]string type, ignoring any conversion errors.
@@ -39,21 +46,25 @@ func StringAnyMapE[K ~string](o any) (map[K]any, error) {
 // Example:
 //
 //	result := StringStringMap[string, string](map[string]string{"key": "value"}) // returns map[string]string{"key": "value"}
-func StringStringMap[K ~string, V ~string](o any) map[K]V {
-	v, _ := StringStringMapE[K, V](o)
+func StringStringMap[K ~string, V ~string](o any, opts ...Option) map[K]V {
+	v, _ := StringStringMapE[K, V](o, opts...)
 	return v
 }
 
 // StringStringMapE casts an interface to a map[string]string type, returning both the converted map and any error encountered.
 // This function is useful when you need to handle conversion errors explicitly.
 // K and V must be string types.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := StringStringMapE[string, string](map[string]string{"key": "value"}) // returns map[string]string{"key": "value"}, nil
+//	result, err := StringStringMapE[string, string](http.Header{"Accept": {"text/html", "*/*"}}) // returns map[string]string{"Accept": "text/html"}, nil
+//	result, err := StringStringMapE[string, string]([][2]string{{"env", "prod"}}) // returns map[string]string{"env": "prod"}, nil
 //	result, err := StringStringMapE[string, string]("invalid") // returns nil, error
-func StringStringMapE[K ~string, V ~string](o any) (map[K]V, error) {
-	return mapE[map[K]V](o, StringE[K], StringE[V])
+func StringStringMapE[K ~string, V ~string](o any, opts ...Option) (map[K]V, error) {
+	c := converterWith(opts)
+	return mapE[map[K]V](c, o, bind(c, stringE[K]), bind(c, stringE[V]))
 }
 
 // StringBoolMap casts an interface to a map[string]bool type, ignoring any conversion errors.
@@ -63,21 +74,23 @@ func StringStringMapE[K ~string, V ~string](o any) (map[K]V, error) {
 // Example:
 //
 //	result := StringBoolMap[string, bool](map[string]bool{"key": true}) // returns map[string]bool{"key": true}
-func StringBoolMap[K ~string, V ~bool](o any) map[K]V {
-	v, _ := StringBoolMapE[K, V](o)
+func StringBoolMap[K ~string, V ~bool](o any, opts ...Option) map[K]V {
+	v, _ := StringBoolMapE[K, V](o, opts...)
 	return v
 }
 
 // StringBoolMapE casts an interface to a map[string]bool type, returning both the converted map and any error encountered.
 // This function is useful when you need to handle conversion errors explicitly.
 // K must be a string type and V must be a boolean type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := StringBoolMapE[string, bool](map[string]bool{"key": true}) // returns map[string]bool{"key": true}, nil
 //	result, err := StringBoolMapE[string, bool]("invalid") // returns nil, error
-func StringBoolMapE[K ~string, V ~bool](o any) (map[K]V, error) {
-	return mapE[map[K]V](o, StringE[K], BoolE[V])
+func StringBoolMapE[K ~string, V ~bool](o any, opts ...Option) (map[K]V, error) {
+	c := converterWith(opts)
+	return mapE[map[K]V](c, o, bind(c, stringE[K]), bind(c, boolE[V]))
 }
 
 // StringFloatMap casts an interface to a map[string]float type, ignoring any conversion errors.
@@ -87,21 +100,23 @@ func StringBoolMapE[K ~string, V ~bool](o any) (map[K]V, error) {
 // Example:
 //
 //	result := StringFloatMap[string, float64](map[string]float64{"key": 3.14}) // returns map[string]float64{"key": 3.14}
-func StringFloatMap[K ~string, V constraints.Float](o any) map[K]V {
-	v, _ := StringFloatMapE[K, V](o)
+func StringFloatMap[K ~string, V constraints.Float](o any, opts ...Option) map[K]V {
+	v, _ := StringFloatMapE[K, V](o, opts...)
 	return v
 }
 
 // StringFloatMapE casts an interface to a map[string]float type, returning both the converted map and any error encountered.
 // This function is useful when you need to handle conversion errors explicitly.
 // K must be a string type and V must be a floating-point type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := StringFloatMapE[string, float64](map[string]float64{"key": 3.14}) // returns map[string]float64{"key": 3.14}, nil
 //	result, err := StringFloatMapE[string, float64]("invalid") // returns nil, error
-func StringFloatMapE[K ~string, V constraints.Float](o any) (map[K]V, error) {
-	return mapE[map[K]V](o, StringE[K], FloatE[V])
+func StringFloatMapE[K ~string, V constraints.Float](o any, opts ...Option) (map[K]V, error) {
+	c := converterWith(opts)
+	return mapE[map[K]V](c, o, bind(c, stringE[K]), bind(c, floatE[V]))
 }
 
 // StringIntMap casts an interface to a map[string]int type, ignoring any conversion errors.
@@ -111,21 +126,23 @@ func StringFloatMapE[K ~string, V constraints.Float](o any) (map[K]V, error) {
 // Example:
 //
 //	result := StringIntMap[string, int64](map[string]int64{"key": 42}) // returns map[string]int64{"key": 42}
-func StringIntMap[K ~string, V constraints.Signed](o any) map[K]V {
-	v, _ := StringIntMapE[K, V](o)
+func StringIntMap[K ~string, V constraints.Signed](o any, opts ...Option) map[K]V {
+	v, _ := StringIntMapE[K, V](o, opts...)
 	return v
 }
 
 // StringIntMapE casts an interface to a map[string]int type, returning both the converted map and any error encountered.
 // This function is useful when you need to handle conversion errors explicitly.
 // K must be a string type and V must be a signed integer type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := StringIntMapE[string, int64](map[string]int64{"key": 42}) // returns map[string]int64{"key": 42}, nil
 //	result, err := StringIntMapE[string, int64]("invalid") // returns nil, error
-func StringIntMapE[K ~string, V constraints.Signed](o any) (map[K]V, error) {
-	return mapE[map[K]V](o, StringE[K], IntE[V])
+func StringIntMapE[K ~string, V constraints.Signed](o any, opts ...Option) (map[K]V, error) {
+	c := converterWith(opts)
+	return mapE[map[K]V](c, o, bind(c, stringE[K]), bind(c, intE[V]))
 }
 
 // StringUintMap casts an interface to a map[string]uint type, ignoring any conversion errors.
@@ -135,21 +152,23 @@ func StringIntMapE[K ~string, V constraints.Signed](o any) (map[K]V, error) {
 // Example:
 //
 //	result := StringUintMap[string, uint64](map[string]uint64{"key": 42}) // returns map[string]uint64{"key": 42}
-func StringUintMap[K ~string, V constraints.Unsigned](o any) map[K]V {
-	v, _ := StringUintMapE[K, V](o)
+func StringUintMap[K ~string, V constraints.Unsigned](o any, opts ...Option) map[K]V {
+	v, _ := StringUintMapE[K, V](o, opts...)
 	return v
 }
 
 // StringUintMapE casts an interface to a map[string]uint type, returning both the converted map and any error encountered.
 // This function is useful when you need to handle conversion errors explicitly.
 // K must be a string type and V must be an unsigned integer type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := StringUintMapE[string, uint64](map[string]uint64{"key": 42}) // returns map[string]uint64{"key": 42}, nil
 //	result, err := StringUintMapE[string, uint64]("invalid") // returns nil, error
-func StringUintMapE[K ~string, V constraints.Unsigned](o any) (map[K]V, error) {
-	return mapE[map[K]V](o, StringE[K], UintE[V])
+func StringUintMapE[K ~string, V constraints.Unsigned](o any, opts ...Option) (map[K]V, error) {
+	c := converterWith(opts)
+	return mapE[map[K]V](c, o, bind(c, stringE[K]), bind(c, uintE[V]))
 }
 
 // StringStringSliceMap casts an interface to a map[string][]string type, ignoring any conversion errors.
@@ -158,75 +177,357 @@ func StringUintMapE[K ~string, V constraints.Unsigned](o any) (map[K]V, error) {
 // Example:
 //
 //	result := StringStringSliceMap(map[string][]string{"key": {"value1", "value2"}}) // returns map[string][]string{"key": {"value1", "value2"}}
-func StringStringSliceMap(o any) map[string][]string {
-	v, _ := StringStringSliceMapE(o)
+func StringStringSliceMap(o any, opts ...Option) map[string][]string {
+	v, _ := StringStringSliceMapE(o, opts...)
 	return v
 }
 
 // StringStringSliceMapE casts an interface to a map[string][]string type, returning both the converted map and any error encountered.
 // This function is useful when you need to handle conversion errors explicitly.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := StringStringSliceMapE(map[string][]string{"key": {"value1", "value2"}}) // returns map[string][]string{"key": {"value1", "value2"}}, nil
+//	result, err := StringStringSliceMapE(url.Values{"tag": {"a", "b"}}) // returns map[string][]string{"tag": {"a", "b"}}, nil
 //	result, err := StringStringSliceMapE("invalid") // returns nil, error
-func StringStringSliceMapE(o any) (map[string][]string, error) {
-	return mapE[map[string][]string](o, StringE[string], StringSE[[]string])
+func StringStringSliceMapE(o any, opts ...Option) (map[string][]string, error) {
+	c := converterWith(opts)
+	return mapE[map[string][]string](c, o, bind(c, stringE[string]), func(o any) ([]string, error) {
+		return toSliceE[[]string](c, o, bind(c, stringE[string]))
+	})
+}
+
+// IntStringMap casts an interface to a map[int]string type, ignoring any conversion errors.
+// It returns an empty map if conversion fails.
+// K must be a signed integer type and V must be a string type.
+//
+// Example:
+//
+//	result := IntStringMap[int64, string](map[string]any{"1": "a", "2": 3}) // returns map[int64]string{1: "a", 2: "3"}
+func IntStringMap[K constraints.Signed, V ~string](o any, opts ...Option) map[K]V {
+	v, _ := IntStringMapE[K, V](o, opts...)
+	return v
+}
+
+// IntStringMapE casts an interface to a map[int]string type, returning both the converted map and any error encountered.
+// This function is useful when you need to handle conversion errors explicitly.
+// Keys are converted like any signed integer, so numeric IDs may arrive as strings, including as the keys of JSON objects.
+// K must be a signed integer type and V must be a string type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
+//
+// Example:
+//
+//	result, err := IntStringMapE[int64, string](`{"1": "a", "2": "b"}`) // returns map[int64]string{1: "a", 2: "b"}, nil
+//	result, err := IntStringMapE[int64, string](map[string]string{"x": "a"}) // returns nil, error
+func IntStringMapE[K constraints.Signed, V ~string](o any, opts ...Option) (map[K]V, error) {
+	c := converterWith(opts)
+	return mapE[map[K]V](c, o, bind(c, intE[K]), bind(c, stringE[V]))
+}
+
+// IntAnyMap casts an interface to a map[int]any type, ignoring any conversion errors.
+// It returns an empty map if conversion fails.
+// K must be a signed integer type.
+//
+// Example:
+//
+//	result := IntAnyMap[int](map[string]any{"1": true}) // returns map[int]any{1: true}
+func IntAnyMap[K constraints.Signed](o any, opts ...Option) map[K]any {
+	v, _ := IntAnyMapE[K](o, opts...)
+	return v
+}
+
+// IntAnyMapE casts an interface to a map[int]any type, returning both the converted map and any error encountered.
+// This function is useful when you need to handle conversion errors explicitly.
+// Only the keys are converted; the values are kept as they are.
+// K must be a signed integer type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
+//
+// Example:
+//
+//	result, err := IntAnyMapE[int](map[string]any{"1": true}) // returns map[int]any{1: true}, nil
+//	result, err := IntAnyMapE[int]("invalid") // returns nil, error
+func IntAnyMapE[K constraints.Signed](o any, opts ...Option) (map[K]any, error) {
+	c := converterWith(opts)
+	return mapE[map[K]any](c, o, bind(c, intE[K]), func(o any) (any, error) { return o, nil })
+}
+
+// UintIntMap casts an interface to a map[uint]int type, ignoring any conversion errors.
+// It returns an empty map if conversion fails.
+// K must be an unsigned integer type and V must be a signed integer type.
+//
+// Example:
+//
+//	result := UintIntMap[uint32, int](map[string]string{"7": "-1"}) // returns map[uint32]int{7: -1}
+func UintIntMap[K constraints.Unsigned, V constraints.Signed](o any, opts ...Option) map[K]V {
+	v, _ := UintIntMapE[K, V](o, opts...)
+	return v
+}
+
+// UintIntMapE casts an interface to a map[uint]int type, returning both the converted map and any error encountered.
+// This function is useful when you need to handle conversion errors explicitly.
+// K must be an unsigned integer type and V must be a signed integer type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
+//
+// Example:
+//
+//	result, err := UintIntMapE[uint32, int](map[string]string{"7": "-1"}) // returns map[uint32]int{7: -1}, nil
+//	result, err := UintIntMapE[uint32, int](map[string]string{"-7": "1"}) // returns nil, error (negative key)
+func UintIntMapE[K constraints.Unsigned, V constraints.Signed](o any, opts ...Option) (map[K]V, error) {
+	c := converterWith(opts)
+	return mapE[map[K]V](c, o, bind(c, uintE[K]), bind(c, intE[V]))
 }
 
 // MapE is a generic function that casts an interface to a map type, returning both the converted map and any error encountered.
 // M is the target map type, K is the key type, and V is the value type.
 // key is a function that converts keys, and val is a function that converts values.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := MapE[map[string]int](map[string]string{"key": "42"}, StringE[string], IntE[int])
 //	// returns map[string]int{"key": 42}, nil
-func MapE[M ~map[K]V, K comparable, V any](o any, key func(o any) (K, error), val func(o any) (V, error)) (M, error) {
-	return mapE[M, K, V](o, key, val)
+//	result, err := MapE[map[int64]time.Duration](`{"1": "1s", "2": "5m"}`, IntE[int64], DurationE)
+//	// returns map[int64]time.Duration{1: time.Second, 2: 5 * time.Minute}, nil
+func MapE[M ~map[K]V, K comparable, V any](o any, key func(o any) (K, error), val func(o any) (V, error), opts ...Option) (M, error) {
+	return mapE[M, K, V](converterWith(opts), o, key, val)
 }
 
 // mapE is the core implementation of map conversion with error handling.
-// It uses JSON unmarshaling for string inputs and reflection for map inputs.
+// It uses JSON unmarshaling for string inputs, falling back to parsing delimited strings such as "k=v,k2=v2",
+// and reflection for map inputs.
+// Structs, url.Values, http.Header and slices of pairs are converted entry by entry, see mapEntries.
 // M is the target map type, K is the key type, and V is the value type.
 // key is a function that converts keys, and val is a function that converts values.
-func mapE[M ~map[K]V, K comparable, V any](o any, key func(o any) (K, error), val func(o any) (V, error)) (M, error) {
+func mapE[M ~map[K]V, K comparable, V any](c *Converter, o any, key func(o any) (K, error), val func(o any) (V, error)) (M, error) {
 	var zero M
 	// Handle nil input by returning zero value
 	if o == nil {
 		return zero, nil
 	}
 
+	// Registered conversions take precedence over the built-in ones
+	if r, ok, err := registered[M](o); ok {
+		return r, err
+	}
+
+	// Handle nil pointers, such as a nil *T for a struct T, by returning the zero value as StructE does
+	if _, ok := indirect(o); !ok {
+		return zero, nil
+	}
+
+	// Handle structs, multi-valued maps and slices of pairs by converting their entries
+	entries, hasEntries, err := mapEntries(o, isMultiValued(typeOf[V]()))
+	if err != nil {
+		return failedCastErrValue[M](o, err)
+	}
+
 	// Handle string input by JSON unmarshaling
 	if s, ok := o.(string); ok {
 		res := make(M)
 		err := json.Unmarshal([]byte(s), &res)
+		if err == nil {
+			return res, nil
+		}
+		// JSON objects that do not unmarshal into M directly and delimited strings are converted pair by pair below
+		entries, err = stringEntries(c, s)
 		if err != nil {
 			return failedCastErrValue[M](o, err)
 		}
+		hasEntries = true
+	}
+
+	res := make(M)
+	var errs elementErrors
+	// put converts mapKey with key and elem with val and stores them in res
+	put := func(mapKey, elem any) error {
+		k, err := key(mapKey)
+		if err != nil {
+			_, err = castErrValue[K](mapKey, err)
+			return atPath(err, keySegment(mapKey))
+		}
+		if !hashable(k) {
+			err := newCastError[K](mapKey, fmt.Errorf("%w: unhashable map key of type %T", ErrUnsupported, k))
+			return atPath(err, keySegment(mapKey))
+		}
+		v, err := val(elem)
+		if err != nil {
+			partial := c.partial(err)
+			_, err = castErrValue[V](elem, err)
+			switch {
+			case partial:
+				// Nested slices, maps and structs keep the elements that did convert
+				res[k] = v
+			case c.invalid == InvalidElementsReplace:
+				res[k] = replacement(c, val)
+			}
+			return atPath(err, keySegment(mapKey))
+		}
+		res[k] = v
+		return nil
+	}
+	// done returns the result once all entries have been converted
+	done := func() (M, error) {
+		if err := errs.err(o, typeOf[M]()); err != nil {
+			if c.lenient() {
+				return res, err
+			}
+			return zero, err
+		}
 		return res, nil
 	}
 
+	if hasEntries {
+		for _, e := range entries {
+			if err := put(e.key, e.val); err != nil {
+				if c.invalid == InvalidElementsReject {
+					return zero, err
+				}
+				errs.add(e.key, err)
+			}
+		}
+		return done()
+	}
+
 	// Check if input is a map type
 	oType := reflect.TypeOf(o)
 	if oType.Kind() != reflect.Map {
 		return failedCastValue[M](o)
 	}
 
-	// Create result map and populate it by converting each key-value pair
-	res := make(M)
-	resVal := reflect.ValueOf(res)
+	// Populate the result map by converting each key-value pair
 	oValue := reflect.ValueOf(o)
-	for _, keyVal := range oValue.MapKeys() {
-		k, err := key(oValue.MapIndex(keyVal).Interface())
-		if err != nil {
-			return zero, err
+	keys := oValue.MapKeys()
+	if c.invalid != InvalidElementsReject {
+		// Sort the keys so that failures are reported in a stable order
+		sort.Slice(keys, func(i, j int) bool {
+			return keySegment(keys[i].Interface()) < keySegment(keys[j].Interface())
+		})
+	}
+	for _, keyVal := range keys {
+		if err := put(keyVal.Interface(), oValue.MapIndex(keyVal).Interface()); err != nil {
+			if c.invalid == InvalidElementsReject {
+				return zero, err
+			}
+			errs.add(keyVal.Interface(), err)
 		}
-		v, err := val(oValue.MapIndex(keyVal).Interface())
+	}
+	return done()
+}
+
+// hashable reports whether k can be used as a map key.
+// Keys of interface, struct and array types may hold slices, maps or functions, which cannot.
+func hashable[K comparable](k K) bool {
+	switch typeOf[K]().Kind() {
+	case reflect.Interface, reflect.Struct, reflect.Array:
+		v := reflect.ValueOf(any(k))
+		return !v.IsValid() || v.Comparable()
+	default:
+		return true
+	}
+}
+
+// mapEntry is a key and value of an input to a map conversion that is not a map itself.
+type mapEntry struct {
+	key any
+	val any
+}
+
+// mapEntries returns the entries of o if it is one of the inputs that map conversions accept besides maps:
+//   - a struct, encoded as a map keyed by the names of its fields, see StructE;
+//   - a url.Values or http.Header, with all values of each key if multi is true, or only the first value otherwise;
+//   - a slice or array of two-element arrays, such as [][2]string, holding keys and values;
+//   - a slice or array of structs with two fields, such as []KeyValue, holding keys and values in that order.
+//
+// It reports false for any other input, and returns an error if a struct cannot be encoded.
+func mapEntries(o any, multi bool) ([]mapEntry, bool, error) {
+	switch v := o.(type) {
+	case url.Values:
+		return multiValuedEntries(v, multi), true, nil
+	case http.Header:
+		return multiValuedEntries(v, multi), true, nil
+	}
+
+	v := indirectValue(reflect.ValueOf(o))
+	if isEncodedStruct(v.Type()) {
+		fields, err := structToMap(v)
 		if err != nil {
-			return zero, err
+			return nil, true, err
+		}
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		entries := make([]mapEntry, len(names))
+		for i, name := range names {
+			entries[i] = mapEntry{key: name, val: fields[name]}
+		}
+		return entries, true, nil
+	}
+
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false, nil
+	}
+	elemType := v.Type().Elem()
+	switch {
+	case elemType.Kind() == reflect.Array && elemType.Len() == 2:
+		entries := make([]mapEntry, v.Len())
+		for i := range entries {
+			pair := v.Index(i)
+			entries[i] = mapEntry{key: pair.Index(0).Interface(), val: pair.Index(1).Interface()}
 		}
-		resVal.SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(v))
+		return entries, true, nil
+	case isPairStruct(elemType):
+		entries := make([]mapEntry, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			pair := indirectValue(v.Index(i))
+			if pair.Kind() == reflect.Pointer {
+				continue
+			}
+			fields := structFields(pair.Type())
+			k, kok := fieldByIndexNoAlloc(pair, fields[0].index)
+			val, vok := fieldByIndexNoAlloc(pair, fields[1].index)
+			if kok && vok {
+				entries = append(entries, mapEntry{key: k.Interface(), val: val.Interface()})
+			}
+		}
+		return entries, true, nil
+	}
+	return nil, false, nil
+}
+
+// multiValuedEntries returns the entries of a url.Values or http.Header in key order,
+// with all values of each key if multi is true, or only the first value otherwise.
+// Keys without values have no first value and are left out unless multi is true.
+func multiValuedEntries(m map[string][]string, multi bool) []mapEntry {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	entries := make([]mapEntry, 0, len(keys))
+	for _, k := range keys {
+		switch vs := m[k]; {
+		case multi:
+			entries = append(entries, mapEntry{key: k, val: vs})
+		case len(vs) > 0:
+			entries = append(entries, mapEntry{key: k, val: vs[0]})
+		}
+	}
+	return entries
+}
+
+// isMultiValued reports whether values of the map value type t hold all values of a multi-valued key.
+func isMultiValued(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice || t.Kind() == reflect.Array
+}
+
+// isPairStruct reports whether t, or the type t points to, is a struct with exactly two fields,
+// such as a KeyValue struct with a key and a value.
+func isPairStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
 	}
-	return res, nil
+	return isEncodedStruct(t) && len(structFields(t)) == 2
 }
@@ -1,9 +1,117 @@
 package gonv
 
-import "testing"
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
 
 // map.go currently may provide helpers for maps; at minimum ensure package builds.
 func TestMapPackageBuilds(t *testing.T) {
 	// This test ensures the package and map-related code compile.
 	// No runtime assertion needed here.
 }
+
+func TestMapE_Keys(t *testing.T) {
+	if v, err := StringIntMapE[string, int](map[string]string{"a": "1"}); err != nil || !reflect.DeepEqual(v, map[string]int{"a": 1}) {
+		t.Errorf("StringIntMapE() = %v, %v", v, err)
+	}
+	if v, err := StringAnyMapE[string](map[any]any{"a": 1, 2: "b"}); err != nil || !reflect.DeepEqual(v, map[string]any{"a": 1, "2": "b"}) {
+		t.Errorf("StringAnyMapE() = %v, %v", v, err)
+	}
+	if v, err := IntStringMapE[int64, string](`{"1": "a", "2": "b"}`); err != nil || !reflect.DeepEqual(v, map[int64]string{1: "a", 2: "b"}) {
+		t.Errorf("IntStringMapE(JSON) = %v, %v", v, err)
+	}
+	if v, err := IntStringMapE[int64, string](`{"1": 1.5}`); err != nil || !reflect.DeepEqual(v, map[int64]string{1: "1.5"}) {
+		t.Errorf("IntStringMapE(JSON numbers) = %v, %v", v, err)
+	}
+	if v, err := IntAnyMapE[int](map[string]any{"1": true}); err != nil || !reflect.DeepEqual(v, map[int]any{1: true}) {
+		t.Errorf("IntAnyMapE() = %v, %v", v, err)
+	}
+	if v, err := UintIntMapE[uint32, int](map[string]string{"7": "-1"}); err != nil || !reflect.DeepEqual(v, map[uint32]int{7: -1}) {
+		t.Errorf("UintIntMapE() = %v, %v", v, err)
+	}
+	if v, err := MapE[map[int64]time.Duration](`{"1": "1s", "2": "5m"}`, IntE[int64], DurationE); err != nil ||
+		!reflect.DeepEqual(v, map[int64]time.Duration{1: time.Second, 2: 5 * time.Minute}) {
+		t.Errorf("MapE(JSON) = %v, %v", v, err)
+	}
+	if v, err := To[map[int64]time.Duration](map[string]any{"1": 1000}); err != nil || !reflect.DeepEqual(v, map[int64]time.Duration{1: 1000}) {
+		t.Errorf("To() = %v, %v", v, err)
+	}
+
+	_, err := UintIntMapE[uint32, int](map[string]string{"-7": "1"})
+	var castErr *CastError
+	if !errors.Is(err, ErrNegative) || !errors.As(err, &castErr) || castErr.Value != "-7" || castErr.Path != `["-7"]` {
+		t.Errorf("UintIntMapE(negative key) = %v", err)
+	}
+	if _, err := IntStringMapE[int64, string](`{"x": "a"}`); !errors.Is(err, ErrSyntax) {
+		t.Errorf("IntStringMapE(bad key) = %v", err)
+	}
+	if _, err := IntStringMapE[int64, string](`not json`); err == nil {
+		t.Errorf("IntStringMapE(not json) expected error")
+	}
+}
+
+type testKeyValue struct {
+	Key   string
+	Value int
+}
+
+func TestMapE_Entries(t *testing.T) {
+	query := url.Values{"a": {"1", "2"}, "b": {"3"}, "c": {}}
+	if v, err := StringIntMapE[string, int](query); err != nil || !reflect.DeepEqual(v, map[string]int{"a": 1, "b": 3}) {
+		t.Errorf("StringIntMapE(url.Values) = %v, %v", v, err)
+	}
+	if v, err := StringStringSliceMapE(query); err != nil || !reflect.DeepEqual(v, map[string][]string{"a": {"1", "2"}, "b": {"3"}, "c": {}}) {
+		t.Errorf("StringStringSliceMapE(url.Values) = %v, %v", v, err)
+	}
+	header := http.Header{"Accept": {"text/html", "application/json"}}
+	if v, err := StringStringMapE[string, string](header); err != nil || !reflect.DeepEqual(v, map[string]string{"Accept": "text/html"}) {
+		t.Errorf("StringStringMapE(http.Header) = %v, %v", v, err)
+	}
+	if v, err := To[map[string][]string](header); err != nil || !reflect.DeepEqual(v, map[string][]string(header)) {
+		t.Errorf("To(http.Header) = %v, %v", v, err)
+	}
+	if v, err := StringAnyMapE[string](header); err != nil || !reflect.DeepEqual(v, map[string]any{"Accept": "text/html"}) {
+		t.Errorf("StringAnyMapE(http.Header) = %v, %v", v, err)
+	}
+
+	kvs := []testKeyValue{{"a", 1}, {"b", 2}}
+	if v, err := StringStringMapE[string, string](kvs); err != nil || !reflect.DeepEqual(v, map[string]string{"a": "1", "b": "2"}) {
+		t.Errorf("StringStringMapE([]KeyValue) = %v, %v", v, err)
+	}
+	if v, err := MapE[map[int]string]([]*testKeyValue{{"1", 1}, nil}, IntE[int], StringE[string]); err != nil || !reflect.DeepEqual(v, map[int]string{1: "1"}) {
+		t.Errorf("MapE([]*KeyValue) = %v, %v", v, err)
+	}
+	pairs := [][2]string{{"a", "1"}, {"b", "2"}, {"a", "3"}}
+	if v, err := StringIntMapE[string, int](pairs); err != nil || !reflect.DeepEqual(v, map[string]int{"a": 3, "b": 2}) {
+		t.Errorf("StringIntMapE([][2]string) = %v, %v", v, err)
+	}
+
+	_, err := StringIntMapE[string, int]([][2]string{{"a", "x"}})
+	var castErr *CastError
+	if !errors.As(err, &castErr) || castErr.Path != `["a"]` {
+		t.Errorf("StringIntMapE(bad pair) = %v", err)
+	}
+	if _, err := StringIntMapE[string, int]([]string{"a"}); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("StringIntMapE([]string) = %v", err)
+	}
+}
+
+func TestMapE_UnhashableKeys(t *testing.T) {
+	identity := func(o any) (any, error) { return o, nil }
+	var castErr *CastError
+	if _, err := To[map[any]int]([][2]any{{[]int{1}, 2}}); !errors.Is(err, ErrUnsupported) || !errors.As(err, &castErr) || castErr.Path != "[[1]]" {
+		t.Errorf("To[map[any]int]() = %v", err)
+	}
+	if _, err := MapE[map[any]any]([][2]any{{"a", 1}, {map[string]int{}, 2}}, identity, identity); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("MapE[map[any]any]() = %v", err)
+	}
+	m, err := MapE[map[any]any]([][2]any{{"a", 1}, {[]int{1}, 2}}, identity, identity, WithInvalidElements(InvalidElementsSkip))
+	if !reflect.DeepEqual(m, map[any]any{"a": 1}) || err == nil {
+		t.Errorf("MapE[map[any]any](skip) = %v, %v", m, err)
+	}
+}
@@ -0,0 +1,115 @@
+package gonv
+
+import (
+	"errors"
+	"reflect"
+	"sync/atomic"
+)
+
+// registryKey identifies a registered conversion by its source and target types.
+type registryKey struct {
+	from reflect.Type
+	to   reflect.Type
+}
+
+// registry holds the current, immutable snapshot of the registered conversions.
+// Writers replace the snapshot instead of modifying it, so readers never need a lock.
+var registry atomic.Pointer[map[registryKey]func(o any) (any, error)]
+
+// Register registers fn as the conversion from From to To.
+// The converters of this package consult registered conversions before any built-in conversion,
+// so user-defined types take part in every conversion, including elements of slices and maps.
+// From is matched against the dynamic type of the value being converted and must be a concrete type.
+// Registering a second conversion between the same types replaces the first.
+// It is safe to call concurrently with conversions.
+//
+// Example:
+//
+//	type Money struct{ Cents int64 }
+//	Register(func(m Money) (float64, error) { return float64(m.Cents) / 100, nil })
+//	result := Float[float64](Money{Cents: 1234})                 // returns 12.34
+//	result := FloatS[[]float64]([]Money{{Cents: 1}, {Cents: 2}}) // returns []float64{0.01, 0.02}
+func Register[From, To any](fn func(From) (To, error)) {
+	key := registryKey{from: typeOf[From](), to: typeOf[To]()}
+	conv := func(o any) (any, error) {
+		return fn(o.(From))
+	}
+	for {
+		old := registry.Load()
+		next := make(map[registryKey]func(o any) (any, error))
+		if old != nil {
+			for k, v := range *old {
+				next[k] = v
+			}
+		}
+		next[key] = conv
+		if registry.CompareAndSwap(old, &next) {
+			return
+		}
+	}
+}
+
+// Unregister removes the conversion from From to To registered with Register, if any.
+// It is safe to call concurrently with conversions.
+//
+// Example:
+//
+//	Unregister[Money, float64]()
+func Unregister[From, To any]() {
+	key := registryKey{from: typeOf[From](), to: typeOf[To]()}
+	for {
+		old := registry.Load()
+		if old == nil {
+			return
+		}
+		if _, ok := (*old)[key]; !ok {
+			return
+		}
+		next := make(map[registryKey]func(o any) (any, error), len(*old)-1)
+		for k, v := range *old {
+			if k != key {
+				next[k] = v
+			}
+		}
+		if registry.CompareAndSwap(old, &next) {
+			return
+		}
+	}
+}
+
+// registered converts o to E with the conversion registered from the type of o to E.
+// It reports false if no such conversion is registered.
+func registered[E any](o any) (E, bool, error) {
+	var zero E
+	v, ok, err := registeredValue(o, typeOf[E]())
+	if !ok || err != nil {
+		return zero, ok, err
+	}
+	r, _ := v.Interface().(E)
+	return r, true, nil
+}
+
+// registeredValue converts o to the type t with the conversion registered from the type of o to t.
+// It reports false if no such conversion is registered.
+func registeredValue(o any, t reflect.Type) (reflect.Value, bool, error) {
+	convs := registry.Load()
+	if convs == nil {
+		return reflect.Value{}, false, nil
+	}
+	conv, ok := (*convs)[registryKey{from: reflect.TypeOf(o), to: t}]
+	if !ok {
+		return reflect.Value{}, false, nil
+	}
+	v, err := conv(o)
+	if err != nil {
+		var castErr *CastError
+		if !errors.As(err, &castErr) {
+			err = castError(o, t, err)
+		}
+		return reflect.Zero(t), true, err
+	}
+	if v == nil {
+		return reflect.Zero(t), true, nil
+	}
+	return reflect.ValueOf(v), true, nil
+}
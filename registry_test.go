@@ -0,0 +1,65 @@
+package gonv
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type (
+	testMoney  struct{ Cents int64 }
+	testStatus int
+)
+
+func (s testStatus) String() string {
+	return [...]string{"inactive", "active"}[s]
+}
+
+func TestRegister(t *testing.T) {
+	Register(func(m testMoney) (float64, error) { return float64(m.Cents) / 100, nil })
+	Register(func(s testStatus) (int, error) { return int(s) * 10, nil })
+	Register(func(s string) (testStatus, error) {
+		switch s {
+		case "inactive":
+			return 0, nil
+		case "active":
+			return 1, nil
+		}
+		return 0, errors.New("unknown status")
+	})
+	defer Unregister[testMoney, float64]()
+	defer Unregister[testStatus, int]()
+	defer Unregister[string, testStatus]()
+
+	if v, err := FloatE[float64](testMoney{Cents: 1234}); err != nil || v != 12.34 {
+		t.Errorf("FloatE(Money) = %v, %v", v, err)
+	}
+	if v, err := IntE[int](testStatus(1)); err != nil || v != 10 {
+		t.Errorf("IntE(Status) = %v, %v", v, err)
+	}
+	if v, err := FloatSE[[]float64]([]testMoney{{Cents: 1}, {Cents: 250}}); err != nil || !reflect.DeepEqual(v, []float64{0.01, 2.5}) {
+		t.Errorf("FloatSE([]Money) = %v, %v", v, err)
+	}
+	if v, err := StringFloatMapE[string, float64](map[string]testMoney{"a": {Cents: 5}}); err != nil || !reflect.DeepEqual(v, map[string]float64{"a": 0.05}) {
+		t.Errorf("StringFloatMapE(map[string]Money) = %v, %v", v, err)
+	}
+	if v, err := To[map[testStatus]float64](map[string]testMoney{"active": {Cents: 5}}); err != nil || !reflect.DeepEqual(v, map[testStatus]float64{1: 0.05}) {
+		t.Errorf("To(map[string]Money) = %v, %v", v, err)
+	}
+	if v, err := SliceE[[]testStatus]([]string{"active", "inactive"}, func(o any) (testStatus, error) {
+		v, _, err := registered[testStatus](o)
+		return v, err
+	}); err != nil || !reflect.DeepEqual(v, []testStatus{1, 0}) {
+		t.Errorf("SliceE([]string) = %v, %v", v, err)
+	}
+	_, _, err := registered[testStatus]("deleted")
+	var castErr *CastError
+	if !errors.As(err, &castErr) || castErr.Err.Error() != "unknown status" {
+		t.Errorf("expected *CastError wrapping the registered error, got %v", err)
+	}
+
+	Unregister[testMoney, float64]()
+	if _, err := FloatE[float64](testMoney{Cents: 1}); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported after Unregister, got %v", err)
+	}
+}
@@ -4,10 +4,12 @@
 package gonv
 
 import (
+	"encoding/json"
 	"reflect"
 
 	"golang.org/x/exp/constraints"
 	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // AnySlice casts an interface to a []any type, ignoring any conversion errors.
@@ -17,20 +19,22 @@ import (
 //
 //	result := AnySlice([]string{"a", "b", "c"}) // returns []interface{}{"a", "b", "c"}
 //	result := AnySlice([3]string{"a", "b", "c"}) // returns []interface{}{"a", "b", "c"}
-func AnySlice(o any) []any {
-	v, _ := AnySliceE(o)
+func AnySlice(o any, opts ...Option) []any {
+	v, _ := AnySliceE(o, opts...)
 	return v
 }
 
 // AnySliceE casts an interface to a []any type, returning both the converted slice and any error encountered.
 // This function is useful when you need to handle conversion errors explicitly.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := AnySliceE([]string{"a", "b", "c"}) // returns []interface{}{"a", "b", "c"}, nil
-//	result, err := AnySliceE("not a slice") // returns nil, error
-func AnySliceE(o any) ([]any, error) {
-	return toSliceE[[]any](o, func(o any) (any, error) { return o, nil })
+//	result, err := AnySliceE("a, b") // returns []interface{}{"a", "b"}, nil
+//	result, err := AnySliceE(42) // returns nil, error
+func AnySliceE(o any, opts ...Option) ([]any, error) {
+	return toSliceE[[]any](converterWith(opts), o, func(o any) (any, error) { return o, nil })
 }
 
 // StringSlice casts an interface to a string slice type, ignoring any conversion errors.
@@ -41,8 +45,8 @@ func AnySliceE(o any) ([]any, error) {
 // Example:
 //
 //	result := StringSlice[[]string, string]([]string{"a", "b", "c"}) // returns []string{"a", "b", "c"}
-func StringSlice[S ~[]E, E ~string](o any) S {
-	v, _ := StringSliceE[S](o)
+func StringSlice[S ~[]E, E ~string](o any, opts ...Option) S {
+	v, _ := StringSliceE[S](o, opts...)
 	return v
 }
 
@@ -50,13 +54,17 @@ func StringSlice[S ~[]E, E ~string](o any) S {
 // This function is useful when you need to handle conversion errors explicitly.
 // S is a slice type with elements of string type.
 // E must be a string type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := StringSliceE[[]string, string]([]string{"a", "b", "c"}) // returns []string{"a", "b", "c"}, nil
-//	result, err := StringSliceE[[]string, string]("not a slice") // returns nil, error
-func StringSliceE[S ~[]E, E ~string](o any) (S, error) {
-	return toSliceE[S](o, StringE[E])
+//	result, err := StringSliceE[[]string, string]("a, b c") // returns []string{"a", "b", "c"}, nil
+//	result, err := StringSliceE[[]string, string](`["a", "b"]`) // returns []string{"a", "b"}, nil
+//	result, err := StringSliceE[[]string, string](42) // returns nil, error
+func StringSliceE[S ~[]E, E ~string](o any, opts ...Option) (S, error) {
+	c := converterWith(opts)
+	return toSliceE[S](c, o, bind(c, stringE[E]))
 }
 
 // BoolSlice casts an interface to a boolean slice type, ignoring any conversion errors.
@@ -67,8 +75,8 @@ func StringSliceE[S ~[]E, E ~string](o any) (S, error) {
 // Example:
 //
 //	result := BoolSlice[[]bool, bool]([]bool{true, false, true}) // returns []bool{true, false, true}
-func BoolSlice[S ~[]E, E ~bool](o any) S {
-	v, _ := BoolSliceE[S](o)
+func BoolSlice[S ~[]E, E ~bool](o any, opts ...Option) S {
+	v, _ := BoolSliceE[S](o, opts...)
 	return v
 }
 
@@ -76,13 +84,19 @@ func BoolSlice[S ~[]E, E ~bool](o any) S {
 // This function is useful when you need to handle conversion errors explicitly.
 // S is a slice type with elements of boolean type.
 // E must be a boolean type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := BoolSliceE[[]bool, bool]([]bool{true, false, true}) // returns []bool{true, false, true}, nil
+//	result, err := BoolSliceE[[]bool, bool]("true 0 F") // returns []bool{true, false, false}, nil
+//	result, err := BoolSliceE[[]bool, bool]("true yes 0") // returns nil, error ("yes" is not accepted by strconv.ParseBool)
+//	result, err := BoolSliceE[[]bool, bool]("true yes 0", WithBoolStrings([]string{"true", "yes"}, []string{"false", "0"}))
+//	// returns []bool{true, true, false}, nil
 //	result, err := BoolSliceE[[]bool, bool]("not a slice") // returns nil, error
-func BoolSliceE[S ~[]E, E ~bool](o any) (S, error) {
-	return toSliceE[S](o, BoolE[E])
+func BoolSliceE[S ~[]E, E ~bool](o any, opts ...Option) (S, error) {
+	c := converterWith(opts)
+	return toSliceE[S](c, o, bind(c, boolE[E]))
 }
 
 // FloatSlice casts an interface to a floating-point slice type, ignoring any conversion errors.
@@ -93,8 +107,8 @@ func BoolSliceE[S ~[]E, E ~bool](o any) (S, error) {
 // Example:
 //
 //	result := FloatSlice[[]float64, float64]([]float64{1.1, 2.2, 3.3}) // returns []float64{1.1, 2.2, 3.3}
-func FloatSlice[S ~[]E, E constraints.Float](o any) S {
-	v, _ := FloatSliceE[S](o)
+func FloatSlice[S ~[]E, E constraints.Float](o any, opts ...Option) S {
+	v, _ := FloatSliceE[S](o, opts...)
 	return v
 }
 
@@ -102,13 +116,16 @@ func FloatSlice[S ~[]E, E constraints.Float](o any) S {
 // This function is useful when you need to handle conversion errors explicitly.
 // S is a slice type with elements of floating-point type.
 // E must be a floating-point type (float32 or float64).
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := FloatSliceE[[]float64, float64]([]float64{1.1, 2.2, 3.3}) // returns []float64{1.1, 2.2, 3.3}, nil
+//	result, err := FloatSliceE[[]float64, float64]("1.5; 2") // returns []float64{1.5, 2}, nil
 //	result, err := FloatSliceE[[]float64, float64]("not a slice") // returns nil, error
-func FloatSliceE[S ~[]E, E constraints.Float](o any) (S, error) {
-	return toSliceE[S](o, FloatE[E])
+func FloatSliceE[S ~[]E, E constraints.Float](o any, opts ...Option) (S, error) {
+	c := converterWith(opts)
+	return toSliceE[S](c, o, bind(c, floatE[E]))
 }
 
 // IntSlice casts an interface to a signed integer slice type, ignoring any conversion errors.
@@ -119,8 +136,8 @@ func FloatSliceE[S ~[]E, E constraints.Float](o any) (S, error) {
 // Example:
 //
 //	result := IntSlice[[]int64, int64]([]int64{1, 2, 3}) // returns []int64{1, 2, 3}
-func IntSlice[S ~[]E, E constraints.Signed](o any) S {
-	v, _ := IntSliceE[S](o)
+func IntSlice[S ~[]E, E constraints.Signed](o any, opts ...Option) S {
+	v, _ := IntSliceE[S](o, opts...)
 	return v
 }
 
@@ -128,13 +145,17 @@ func IntSlice[S ~[]E, E constraints.Signed](o any) S {
 // This function is useful when you need to handle conversion errors explicitly.
 // S is a slice type with elements of signed integer type.
 // E must be a signed integer type (int, int8, int16, int32, int64).
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := IntSliceE[[]int64, int64]([]int64{1, 2, 3}) // returns []int64{1, 2, 3}, nil
+//	result, err := IntSliceE[[]int64, int64]("1,2,3") // returns []int64{1, 2, 3}, nil
+//	result, err := IntSliceE[[]int64, int64]("[1, 9007199254740993]") // returns []int64{1, 9007199254740993}, nil
 //	result, err := IntSliceE[[]int64, int64]("not a slice") // returns nil, error
-func IntSliceE[S ~[]E, E constraints.Signed](o any) (S, error) {
-	return toSliceE[S](o, IntE[E])
+func IntSliceE[S ~[]E, E constraints.Signed](o any, opts ...Option) (S, error) {
+	c := converterWith(opts)
+	return toSliceE[S](c, o, bind(c, intE[E]))
 }
 
 // UintSlice casts an interface to an unsigned integer slice type, ignoring any conversion errors.
@@ -145,8 +166,8 @@ func IntSliceE[S ~[]E, E constraints.Signed](o any) (S, error) {
 // Example:
 //
 //	result := UintSlice[[]uint64, uint64]([]uint64{1, 2, 3}) // returns []uint64{1, 2, 3}
-func UintSlice[S ~[]E, E constraints.Unsigned](o any) S {
-	v, _ := UintSliceE[S](o)
+func UintSlice[S ~[]E, E constraints.Unsigned](o any, opts ...Option) S {
+	v, _ := UintSliceE[S](o, opts...)
 	return v
 }
 
@@ -154,13 +175,16 @@ func UintSlice[S ~[]E, E constraints.Unsigned](o any) S {
 // This function is useful when you need to handle conversion errors explicitly.
 // S is a slice type with elements of unsigned integer type.
 // E must be an unsigned integer type (uint, uint8, uint16, uint32, uint64).
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := UintSliceE[[]uint64, uint64]([]uint64{1, 2, 3}) // returns []uint64{1, 2, 3}, nil
+//	result, err := UintSliceE[[]uint64, uint64]("1\n2") // returns []uint64{1, 2}, nil
 //	result, err := UintSliceE[[]uint64, uint64]("not a slice") // returns nil, error
-func UintSliceE[S ~[]E, E constraints.Unsigned](o any) (S, error) {
-	return toSliceE[S](o, UintE[E])
+func UintSliceE[S ~[]E, E constraints.Unsigned](o any, opts ...Option) (S, error) {
+	c := converterWith(opts)
+	return toSliceE[S](c, o, bind(c, uintE[E]))
 }
 
 // Slice is a generic function that casts an interface to a slice type, returning both the converted slice and any error encountered.
@@ -172,53 +196,106 @@ func UintSliceE[S ~[]E, E constraints.Unsigned](o any) (S, error) {
 //
 //	result, err := Slice[[]int, int]([]string{"1", "2", "3"}, IntE[int])
 //	// returns []int{1, 2, 3}, nil
-func Slice[S ~[]E, E any](o any, to func(o any) (E, error)) (S, error) {
-	return SliceE[S](o, to)
+func Slice[S ~[]E, E any](o any, to func(o any) (E, error), opts ...Option) (S, error) {
+	return SliceE[S](o, to, opts...)
 }
 
 // SliceE is a generic function that casts an interface to a slice type, returning both the converted slice and any error encountered.
 // S is a slice type with elements of type E.
 // E is the element type of the slice.
 // to is a function that converts individual elements.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := SliceE[[]int, int]([]string{"1", "2", "3"}, IntE[int])
 //	// returns []int{1, 2, 3}, nil
-func SliceE[S ~[]E, E any](o any, to func(o any) (E, error)) (S, error) {
-	return toSliceE[S, E](o, to)
+//	result, err := SliceE[[]int, int]([]string{"1", "x", "3"}, IntE[int], WithInvalidElements(InvalidElementsSkip))
+//	// returns []int{1, 3}, error listing the index 1
+func SliceE[S ~[]E, E any](o any, to func(o any) (E, error), opts ...Option) (S, error) {
+	return toSliceE[S, E](converterWith(opts), o, to)
 }
 
 // toSliceE is the core implementation of slice conversion with error handling.
 // It uses type assertion for direct slice types and reflection for array/slice conversion.
+// Strings, byte slices holding text, json.RawMessage and *wrapperspb.StringValue holding a JSON array are decoded,
+// with numbers kept as json.Number; others are split into elements at the slice separators of c, see WithSliceSeparators.
 // S is a slice type with elements of type E.
 // E is the element type of the slice.
 // to is a function that converts individual elements.
-func toSliceE[S ~[]E, E any](o any, to func(o any) (E, error)) (S, error) {
+func toSliceE[S ~[]E, E any](c *Converter, o any, to func(o any) (E, error)) (S, error) {
 	var zero S
 	// Handle nil input by returning zero value
 	if o == nil {
 		return zero, nil
 	}
 
+	// Registered conversions take precedence over the built-in ones
+	if r, ok, err := registered[S](o); ok {
+		return r, err
+	}
+
 	// Handle direct type match by cloning the slice
 	if v, ok := o.(S); ok {
 		return slices.Clone(v), nil
 	}
 
+	// Handle string input, and byte slices holding text or converted to strings,
+	// by decoding it as a JSON array or splitting it into elements.
+	// Other byte slices are converted byte by byte below.
+	input := o
+	var err error
+	switch v := o.(type) {
+	case string:
+		o, err = stringElements(c, v)
+	case []byte:
+		if typeOf[E]().Kind() == reflect.String || isText(v) {
+			o, err = stringElements(c, string(v))
+		}
+	case json.RawMessage:
+		o, err = stringElements(c, string(v))
+	case *wrapperspb.StringValue:
+		o, err = stringElements(c, v.GetValue())
+	}
+	if err != nil {
+		return failedCastErrValue[S](input, err)
+	}
+
 	// Check if input is a slice or array type
 	kind := reflect.TypeOf(o).Kind()
 	switch kind {
 	// Handle slice and array types by converting each element
 	case reflect.Slice, reflect.Array:
 		value := reflect.ValueOf(o)
-		res := make(S, value.Len())
+		res := make(S, 0, value.Len())
+		var errs elementErrors
 		for i := 0; i < value.Len(); i++ {
-			val, err := to(value.Index(i).Interface())
+			elem := value.Index(i).Interface()
+			val, err := to(elem)
 			if err != nil {
-				return zero, err
+				partial := c.partial(err)
+				_, err = castErrValue[E](elem, err)
+				err = atPath(err, indexSegment(i))
+				if c.invalid == InvalidElementsReject {
+					return zero, err
+				}
+				errs.add(i, err)
+				switch {
+				case partial:
+					// Nested slices, maps and structs keep the elements that did convert
+				case c.invalid == InvalidElementsReplace:
+					val = replacement(c, to)
+				default:
+					continue
+				}
+			}
+			res = append(res, val)
+		}
+		if err := errs.err(input, typeOf[S]()); err != nil {
+			if c.lenient() {
+				return res, err
 			}
-			res[i] = val
+			return zero, err
 		}
 		return res, nil
 	// Handle unsupported types
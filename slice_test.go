@@ -1,7 +1,107 @@
 package gonv
 
-import "testing"
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
 
 func TestSlicePackageBuilds(t *testing.T) {
 	// Minimal smoke test to verify slice helpers compile and are reachable.
 }
+
+func TestSliceE_Delimited(t *testing.T) {
+	if v, err := IntSliceE[[]int]("1,2,3"); err != nil || !reflect.DeepEqual(v, []int{1, 2, 3}) {
+		t.Errorf("IntSliceE() = %v, %v", v, err)
+	}
+	if v, err := DurationSE("1s, 5m"); err != nil || !reflect.DeepEqual(v, []time.Duration{time.Second, 5 * time.Minute}) {
+		t.Errorf("DurationSE() = %v, %v", v, err)
+	}
+	var castErr *CastError
+	if _, err := BoolSliceE[[]bool]("true yes 0"); !errors.As(err, &castErr) || castErr.Path != "[1]" {
+		t.Errorf("BoolSliceE(default words) = %v", err)
+	}
+	if v, err := BoolSliceE[[]bool]("true yes 0", WithBoolStrings([]string{"true", "yes"}, []string{"0"})); err != nil || !reflect.DeepEqual(v, []bool{true, true, false}) {
+		t.Errorf("BoolSliceE() = %v, %v", v, err)
+	}
+	if v, err := FloatSE[[]float64]([]byte("1.5;\n2")); err != nil || !reflect.DeepEqual(v, []float64{1.5, 2}) {
+		t.Errorf("FloatSE([]byte) = %v, %v", v, err)
+	}
+	if v, err := StringSliceE[[]string]("a b\nc d", WithSliceSeparators("\n")); err != nil || !reflect.DeepEqual(v, []string{"a b", "c d"}) {
+		t.Errorf("StringSliceE(newline) = %v, %v", v, err)
+	}
+	if v, err := StringSliceE[[]string](`a\,b,c`); err != nil || !reflect.DeepEqual(v, []string{"a,b", "c"}) {
+		t.Errorf("StringSliceE(escaped) = %v, %v", v, err)
+	}
+	if v, err := StringSliceE[[]string](" a ,, b ", WithKeepEmpty(), WithSliceSeparators(",")); err != nil || !reflect.DeepEqual(v, []string{"a", "", "b"}) {
+		t.Errorf("StringSliceE(keep empty) = %v, %v", v, err)
+	}
+	if v, err := StringSliceE[[]string](" a , b ", WithTrimSpace(false), WithSliceSeparators(",")); err != nil || !reflect.DeepEqual(v, []string{" a ", " b "}) {
+		t.Errorf("StringSliceE(untrimmed) = %v, %v", v, err)
+	}
+	if v, err := IntSliceE[[]int](""); err != nil || len(v) != 0 {
+		t.Errorf("IntSliceE(empty) = %v, %v", v, err)
+	}
+	if v, err := To[[]byte]("abc"); err != nil || string(v) != "abc" {
+		t.Errorf("To[[]byte]() = %v, %v", v, err)
+	}
+
+	_, err := IntSliceE[[]int]("1,,x", WithKeepEmpty())
+	if !errors.As(err, &castErr) || castErr.Path != "[1]" {
+		t.Errorf("IntSliceE(keep empty) = %v", err)
+	}
+}
+
+func TestSliceE_JSON(t *testing.T) {
+	if v, err := StringSliceE[[]string](`["a", "b c"]`); err != nil || !reflect.DeepEqual(v, []string{"a", "b c"}) {
+		t.Errorf("StringSliceE() = %v, %v", v, err)
+	}
+	if v, err := IntSliceE[[]int]("[1,2,3]"); err != nil || !reflect.DeepEqual(v, []int{1, 2, 3}) {
+		t.Errorf("IntSliceE() = %v, %v", v, err)
+	}
+	if v, err := IntSliceE[[]int64]([]byte(" [9007199254740993] ")); err != nil || !reflect.DeepEqual(v, []int64{9007199254740993}) {
+		t.Errorf("IntSliceE([]byte) = %v, %v", v, err)
+	}
+	if v, err := UintSliceE[[]uint64](json.RawMessage(`[18446744073709551615]`)); err != nil || !reflect.DeepEqual(v, []uint64{18446744073709551615}) {
+		t.Errorf("UintSliceE(json.RawMessage) = %v, %v", v, err)
+	}
+	if v, err := FloatSE[[]float64](wrapperspb.String(`[1.5, "2"]`)); err != nil || !reflect.DeepEqual(v, []float64{1.5, 2}) {
+		t.Errorf("FloatSE(*wrapperspb.StringValue) = %v, %v", v, err)
+	}
+	if v, err := To[[][]int](`[[1, 2], [3]]`); err != nil || !reflect.DeepEqual(v, [][]int{{1, 2}, {3}}) {
+		t.Errorf("To[[][]int]() = %v, %v", v, err)
+	}
+	if v, err := AnySliceE(`["a", 1]`); err != nil || !reflect.DeepEqual(v, []any{"a", json.Number("1")}) {
+		t.Errorf("AnySliceE() = %v, %v", v, err)
+	}
+
+	for _, in := range []string{"[1, 2", "[1] [2]"} {
+		if _, err := IntSliceE[[]int](in); !errors.Is(err, ErrSyntax) {
+			t.Errorf("IntSliceE(%q) = %v, want ErrSyntax", in, err)
+		}
+	}
+	_, err := IntSliceE[[]int](`[1, "x"]`)
+	var castErr *CastError
+	if !errors.As(err, &castErr) || castErr.Path != "[1]" {
+		t.Errorf("IntSliceE(invalid element) = %v", err)
+	}
+}
+
+func TestSliceE_RawBytes(t *testing.T) {
+	if v, err := IntSliceE[[]int]([]byte{1, 2, 3}); err != nil || !reflect.DeepEqual(v, []int{1, 2, 3}) {
+		t.Errorf("IntSliceE(raw bytes) = %v, %v", v, err)
+	}
+	if v, err := AnySliceE([]byte{1, 2}); err != nil || !reflect.DeepEqual(v, []any{byte(1), byte(2)}) {
+		t.Errorf("AnySliceE(raw bytes) = %v, %v", v, err)
+	}
+	if v, err := UintSliceE[[]uint8]([]byte{0, 255}); err != nil || !reflect.DeepEqual(v, []uint8{0, 255}) {
+		t.Errorf("UintSliceE(raw bytes) = %v, %v", v, err)
+	}
+	if v, err := StringSliceE[[]string]([]byte("a,b")); err != nil || !reflect.DeepEqual(v, []string{"a", "b"}) {
+		t.Errorf("StringSliceE(text bytes) = %v, %v", v, err)
+	}
+}
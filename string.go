@@ -38,7 +38,7 @@ func String[E ~string](o any) E {
 //	result, err := StringE[string](42) // returns "42", nil
 //	result, err := StringE[string](nil) // returns "", nil
 func StringE[E ~string](o any) (E, error) {
-	return stringE[E](o)
+	return stringE[E](defaultConverter, o)
 }
 
 // StringS casts an interface to a []string type, ignoring any conversion errors.
@@ -49,8 +49,8 @@ func StringE[E ~string](o any) (E, error) {
 // Example:
 //
 //	result := StringS[[]string, string]([]int{1, 2, 3}) // returns []string{"1", "2", "3"}
-func StringS[S ~[]E, E ~string](o any) S {
-	v, _ := StringSE[S](o)
+func StringS[S ~[]E, E ~string](o any, opts ...Option) S {
+	v, _ := StringSE[S](o, opts...)
 	return v
 }
 
@@ -58,25 +58,33 @@ func StringS[S ~[]E, E ~string](o any) S {
 // This function is useful when you need to handle conversion errors explicitly.
 // S is a slice type with elements of string type.
 // E must be a string type.
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := StringSE[[]string, string]([]int{1, 2, 3}) // returns []string{"1", "2", "3"}, nil
-//	result, err := StringSE[[]string, string]("not a slice") // returns nil, error
-func StringSE[S ~[]E, E ~string](o any) (S, error) {
-	return toSliceE[S](o, stringE[E])
+//	result, err := StringSE[[]string, string]("a, b c") // returns []string{"a", "b", "c"}, nil
+//	result, err := StringSE[[]string, string](42) // returns nil, error
+func StringSE[S ~[]E, E ~string](o any, opts ...Option) (S, error) {
+	c := converterWith(opts)
+	return toSliceE[S](c, o, bind(c, stringE[E]))
 }
 
 // stringE is the core implementation of string conversion with error handling.
 // It uses a fast path approach for common types and falls back to reflection for complex types.
 // E must be a string type.
-func stringE[E ~string](o any) (E, error) {
+func stringE[E ~string](c *Converter, o any) (E, error) {
 	var zero E
 	// Handle nil input by returning zero value
 	if o == nil {
 		return zero, nil
 	}
 
+	// Registered conversions take precedence over the built-in ones
+	if r, ok, err := registered[E](o); ok {
+		return r, err
+	}
+
 	// Fast path: direct type assertions for common types
 	switch s := o.(type) {
 	// Boolean conversion using strconv.FormatBool
@@ -133,7 +141,7 @@ func stringE[E ~string](o any) (E, error) {
 	case json.Number:
 		return E(s.String()), nil
 
-	// Time types: use String() method or Format() with DefaultTimeFormat
+	// Time types: use String() method or Format() with the time format of c
 	case time.Weekday:
 		return E(s.String()), nil
 	case time.Month:
@@ -141,13 +149,13 @@ func stringE[E ~string](o any) (E, error) {
 	case time.Duration:
 		return E(s.String()), nil
 	case time.Time:
-		return E(s.Format(DefaultTimeFormat)), nil
+		return E(s.Format(c.layout())), nil
 
 	// Protobuf types support
 	case *durationpb.Duration:
 		return E(s.AsDuration().String()), nil
 	case *timestamppb.Timestamp:
-		return E(s.AsTime().Format(DefaultTimeFormat)), nil
+		return E(s.AsTime().Format(c.layout())), nil
 
 	// Protobuf wrapper types support
 	case *wrapperspb.BoolValue:
@@ -175,7 +183,7 @@ func stringE[E ~string](o any) (E, error) {
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
-		r, err := stringE[E](v)
+		r, err := stringE[E](c, v)
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
@@ -205,14 +213,14 @@ func stringE[E ~string](o any) (E, error) {
 	// Default case: use reflection-based conversion for complex types
 	default:
 		// slow path
-		return stringVE[E](o)
+		return stringVE[E](c, o)
 	}
 }
 
 // stringVE is the reflection-based (slow path) implementation for string conversion.
 // It's used when fast path type assertions fail and more complex type analysis is needed.
 // E must be a string type.
-func stringVE[E ~string](o any) (E, error) {
+func stringVE[E ~string](c *Converter, o any) (E, error) {
 	// Get the underlying value, dereferencing pointers if necessary
 	v := indirectValue(reflect.ValueOf(o))
 
@@ -244,6 +252,10 @@ func stringVE[E ~string](o any) (E, error) {
 		}
 		return E(string(v.Bytes())), nil
 
+	// Nil pointers that cannot be dereferenced
+	case reflect.Pointer:
+		return failedCastErrValue[E](o, ErrNil)
+
 	// Unsupported types
 	default:
 		return failedCastValue[E](o)
@@ -0,0 +1,469 @@
+package gonv
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Struct converts an interface to the struct type T, ignoring any conversion errors.
+// It returns the zero value of T if conversion fails.
+//
+// Example:
+//
+//	type User struct {
+//		ID   int64  `gonv:"id"`
+//		Name string `json:"name"`
+//	}
+//
+//	result := Struct[User](map[string]any{"id": "42", "name": "gopher"}) // returns User{ID: 42, Name: "gopher"}
+func Struct[T any](o any) T {
+	v, _ := StructE[T](o)
+	return v
+}
+
+// StructE converts an interface to the struct type T, returning both the converted struct and any error encountered.
+// Maps with string keys, such as the map[string]any returned by StringAnyMapE, and JSON objects in strings
+// are decoded field by field: each exported field is looked up under the name given by its `gonv` tag,
+// falling back to its `json` tag and then to the field name, and converted with the converter for its type.
+// Keys are matched exactly first and case-insensitively otherwise, and fields without a key keep their zero value.
+// Fields tagged "-" are ignored, and the fields of embedded structs are decoded as if they belonged to T.
+// Nested structs, pointers, slices and maps are converted recursively.
+// Structs of other types are first encoded as maps, as StringAnyMapE does; see Copy for a per-field report.
+//
+// Example:
+//
+//	type Address struct {
+//		City string `json:"city"`
+//	}
+//	type User struct {
+//		ID      int64         `gonv:"id"`
+//		Timeout time.Duration `json:"timeout"`
+//		Address *Address      `json:"address"`
+//	}
+//
+//	result, err := StructE[User](map[string]any{"id": "42", "timeout": "1s", "address": map[string]any{"city": "Berlin"}})
+//	// returns User{ID: 42, Timeout: time.Second, Address: &Address{City: "Berlin"}}, nil
+//	result, err := StructE[User](map[string]any{"id": "abc"}) // returns User{}, error
+func StructE[T any](o any) (T, error) {
+	return To[T](o)
+}
+
+// structField describes an exported field of a struct as seen by the struct converters.
+type structField struct {
+	// name is the key of the field, from its tag or its Go name.
+	name string
+	// goName is the Go name of the field.
+	goName string
+	// index is the index sequence of the field for reflect.Value.FieldByIndex,
+	// including the indexes of the embedded structs it is promoted through.
+	index []int
+	// typ is the type of the field.
+	typ reflect.Type
+	// omitEmpty reports whether the tag of the field has the omitempty option.
+	omitEmpty bool
+}
+
+// structFieldCache caches the fields of struct types, keyed by reflect.Type.
+var structFieldCache sync.Map
+
+// structFields returns the fields of the struct type t, including the fields promoted from embedded structs.
+// As with Go's own field promotion, a field shadows any field of the same name at a deeper level of embedding.
+func structFields(t reflect.Type) []structField {
+	if fields, ok := structFieldCache.Load(t); ok {
+		return fields.([]structField)
+	}
+	fields := collectStructFields(t, nil, map[reflect.Type]bool{})
+	structFieldCache.Store(t, fields)
+	return fields
+}
+
+// collectStructFields collects the fields of the struct type t, prefixing their indexes with index.
+// visited guards against cycles through embedded pointers.
+func collectStructFields(t reflect.Type, index []int, visited map[reflect.Type]bool) []structField {
+	visited[t] = true
+	defer delete(visited, t)
+
+	var fields []structField
+	var embedded [][]structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, omitEmpty, ok := fieldTag(f)
+		if !ok {
+			continue
+		}
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		// Embedded structs without a tag name are squashed into the outer struct
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				// Pointers to unexported embedded structs cannot be allocated
+				if !f.IsExported() {
+					continue
+				}
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && !visited[ft] {
+				embedded = append(embedded, collectStructFields(ft, fieldIndex, visited))
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, structField{name: name, goName: f.Name, index: fieldIndex, typ: f.Type, omitEmpty: omitEmpty})
+	}
+
+	// Promoted fields are added after the direct ones, unless shadowed by them
+	names := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		names[f.name] = true
+	}
+	for _, promoted := range embedded {
+		for _, f := range promoted {
+			if !names[f.name] {
+				names[f.name] = true
+				fields = append(fields, f)
+			}
+		}
+	}
+	return fields
+}
+
+// fieldTag returns the name and omitempty option of the struct field f,
+// from its `gonv` tag or, if it has none, its `json` tag.
+// It reports false if the field is tagged "-" or is an unexported field that is not an embedded struct.
+func fieldTag(f reflect.StructField) (name string, omitEmpty bool, ok bool) {
+	if !f.IsExported() && !f.Anonymous {
+		return "", false, false
+	}
+	tag, found := f.Tag.Lookup("gonv")
+	if !found {
+		tag = f.Tag.Get("json")
+	}
+	if tag == "-" {
+		return "", false, false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	for opts != "" {
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, true
+}
+
+// fieldByIndex returns the field of the struct v with the given index sequence,
+// allocating nil embedded struct pointers on the way.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// convertToStruct converts o to the struct type t.
+// Maps with string keys, JSON objects and structs of other types are decoded field by field, see StructE.
+func convertToStruct(c *Converter, o any, t reflect.Type) (reflect.Value, error) {
+	o, ok := indirect(o)
+	if !ok || o == nil {
+		return reflect.Zero(t), nil
+	}
+	v := reflect.ValueOf(o)
+	if v.Type() == t {
+		return v, nil
+	}
+
+	// Handle struct input by encoding it as a map first, so that structs of other types are copied by field name
+	if isEncodedStruct(v.Type()) {
+		fields, err := structToMap(v)
+		if err != nil {
+			return reflect.Zero(t), castError(o, t, err)
+		}
+		v = reflect.ValueOf(fields)
+	}
+
+	// Handle string input by JSON unmarshaling into a map first, so that fields are converted by gonv
+	// rather than by encoding/json, and numbers keep their precision as json.Number
+	if s, ok := o.(string); ok {
+		obj, err := decodeObject(s)
+		if err != nil {
+			return reflect.Zero(t), castError(o, t, err)
+		}
+		v = reflect.ValueOf(obj)
+	}
+
+	keys, ok := stringKeys(v)
+	if !ok {
+		return reflect.Zero(t), castError(o, t, ErrUnsupported)
+	}
+	res := reflect.New(t).Elem()
+	var errs elementErrors
+	for _, f := range structFields(t) {
+		elem, ok := lookupKey(keys, f.name)
+		if !ok {
+			continue
+		}
+		fv, err := convertTo(c, elem, f.typ)
+		if err != nil {
+			partial := c.partial(err)
+			err = atPath(err, f.name)
+			if c.invalid == InvalidElementsReject {
+				return reflect.Zero(t), err
+			}
+			errs.add(f.name, err)
+			switch {
+			case partial:
+				// Nested slices, maps and structs keep the elements that did convert
+			case c.invalid == InvalidElementsReplace && c.fallback != nil:
+				if fv, err = convertTo(c, c.fallback, f.typ); err != nil {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+		fieldByIndex(res, f.index).Set(fv)
+	}
+	if err := errs.err(o, t); err != nil {
+		if c.lenient() {
+			return res, err
+		}
+		return reflect.Zero(t), err
+	}
+	return res, nil
+}
+
+// stringKeys returns the entries of the map v whose keys are strings, or interfaces holding strings.
+// It reports false if v is not a map with such keys.
+func stringKeys(v reflect.Value) (map[string]any, bool) {
+	if v.Kind() != reflect.Map {
+		return nil, false
+	}
+	keyKind := v.Type().Key().Kind()
+	if keyKind != reflect.String && keyKind != reflect.Interface {
+		return nil, false
+	}
+	keys := make(map[string]any, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		k := iter.Key()
+		if keyKind == reflect.Interface {
+			k = k.Elem()
+			if k.Kind() != reflect.String {
+				continue
+			}
+		}
+		keys[k.String()] = iter.Value().Interface()
+	}
+	return keys, true
+}
+
+// lookupKey returns the value stored under name in keys,
+// preferring an exact match over a case-insensitive one.
+func lookupKey(keys map[string]any, name string) (any, bool) {
+	if v, ok := keys[name]; ok {
+		return v, true
+	}
+	for k, v := range keys {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// structToMap encodes the struct v as a map keyed by the names of its fields, see structFields.
+// Fields with the omitempty option are left out if they are empty, as defined by encoding/json,
+// and the fields of nil embedded struct pointers are left out.
+// Nested structs, other than time.Time, become nested maps, including those in slices, arrays and maps,
+// and nil pointers and interfaces become untyped nil.
+// Like encoding/json, it returns ErrCycle if v refers to itself through pointers, slices or maps.
+//
+// Example:
+//
+//	type Address struct {
+//		City string `json:"city"`
+//	}
+//	type User struct {
+//		Name    string   `json:"name,omitempty"`
+//		Address *Address `json:"address"`
+//	}
+//
+//	structToMap(reflect.ValueOf(User{Address: &Address{City: "Berlin"}}))
+//	// returns map[string]any{"address": map[string]any{"city": "Berlin"}}, nil
+func structToMap(v reflect.Value) (map[string]any, error) {
+	e := structEncoder{visiting: make(map[visit]struct{})}
+	return e.encodeStruct(v)
+}
+
+// visit identifies a pointer, slice or map being encoded by structEncoder.
+type visit struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+// structEncoder encodes structs as maps for structToMap,
+// tracking the pointers, slices and maps it is encoding to detect cycles.
+type structEncoder struct {
+	visiting map[visit]struct{}
+}
+
+// encodeStruct encodes the struct v as a map keyed by the names of its fields.
+func (e *structEncoder) encodeStruct(v reflect.Value) (map[string]any, error) {
+	fields := structFields(v.Type())
+	res := make(map[string]any, len(fields))
+	for _, f := range fields {
+		fv, ok := fieldByIndexNoAlloc(v, f.index)
+		if !ok || f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		elem, err := e.encodeValue(fv)
+		if err != nil {
+			return nil, err
+		}
+		res[f.name] = elem
+	}
+	return res, nil
+}
+
+// encodeValue returns the value of v for structToMap, turning structs into maps recursively.
+func (e *structEncoder) encodeValue(v reflect.Value) (any, error) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return e.encodeValue(v.Elem())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if !isEncodedStruct(indirectValue(v).Type()) {
+			break
+		}
+		key, err := e.enter(v)
+		if err != nil {
+			return nil, err
+		}
+		defer delete(e.visiting, key)
+		return e.encodeValue(v.Elem())
+	case reflect.Struct:
+		if isEncodedStruct(v.Type()) {
+			return e.encodeStruct(v)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() || !containsStruct(v.Type().Elem()) {
+			break
+		}
+		if v.Kind() == reflect.Slice {
+			key, err := e.enter(v)
+			if err != nil {
+				return nil, err
+			}
+			defer delete(e.visiting, key)
+		}
+		res := make([]any, v.Len())
+		for i := range res {
+			elem, err := e.encodeValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			res[i] = elem
+		}
+		return res, nil
+	case reflect.Map:
+		if v.IsNil() || !containsStruct(v.Type().Elem()) {
+			break
+		}
+		key, err := e.enter(v)
+		if err != nil {
+			return nil, err
+		}
+		defer delete(e.visiting, key)
+		res := reflect.MakeMapWithSize(reflect.MapOf(v.Type().Key(), reflect.TypeOf((*any)(nil)).Elem()), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			elem, err := e.encodeValue(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			res.SetMapIndex(iter.Key(), valueOrZero(elem, res.Type().Elem()))
+		}
+		return res.Interface(), nil
+	}
+	return v.Interface(), nil
+}
+
+// enter records that the pointer, slice or map v is being encoded.
+// It returns ErrCycle if v is already being encoded, that is if v refers to itself.
+func (e *structEncoder) enter(v reflect.Value) (visit, error) {
+	key := visit{typ: v.Type(), ptr: v.Pointer()}
+	if v.Kind() == reflect.Slice {
+		key.len = v.Len()
+	}
+	if _, ok := e.visiting[key]; ok {
+		return key, fmt.Errorf("%w: %s refers to itself", ErrCycle, v.Type())
+	}
+	e.visiting[key] = struct{}{}
+	return key, nil
+}
+
+// isEncodedStruct reports whether t is a struct type that structToMap encodes as a map.
+// time.Time is kept as it is.
+func isEncodedStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+// containsStruct reports whether values of type t may hold structs that structToMap encodes as maps.
+func containsStruct(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return isEncodedStruct(t) || t.Kind() == reflect.Interface
+}
+
+// fieldByIndexNoAlloc returns the field of the struct v with the given index sequence.
+// It reports false if the field is promoted through a nil embedded struct pointer.
+func fieldByIndexNoAlloc(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// isEmptyValue reports whether v is empty for the omitempty option, following encoding/json:
+// false, 0, a nil pointer or interface, and an empty array, slice, map or string.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
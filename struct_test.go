@@ -0,0 +1,196 @@
+package gonv
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type (
+	testAddress struct {
+		City string `json:"city"`
+		Zip  *int   `gonv:"zip"`
+	}
+	testBase struct {
+		ID      int64     `gonv:"id" json:"identifier"`
+		Created time.Time `json:"created"`
+	}
+	testUser struct {
+		testBase
+		*testAudit
+		Name     string         `json:"name,omitempty"`
+		Timeout  time.Duration  `json:"timeout"`
+		Address  *testAddress   `json:"address"`
+		Homes    []testAddress  `json:"homes"`
+		Scores   map[string]int `json:"scores"`
+		Tags     []string       `json:"tags"`
+		Extra    map[string]any `json:"extra"`
+		Ignored  string         `json:"-"`
+		Plain    uint8
+		internal string
+	}
+	testAudit struct {
+		Author string `json:"author"`
+	}
+)
+
+func TestStructE(t *testing.T) {
+	zip := 10115
+	got, err := StructE[testUser](map[string]any{
+		"id":       "42",
+		"created":  "2024-01-02T03:04:05Z",
+		"author":   "ops",
+		"name":     "gopher",
+		"timeout":  "1s",
+		"address":  map[string]any{"city": "Berlin", "zip": "10115"},
+		"homes":    []any{map[string]any{"city": "Paris"}},
+		"scores":   map[string]any{"go": 10},
+		"tags":     []any{"a", 1},
+		"extra":    map[string]any{"k": "v"},
+		"Ignored":  "x",
+		"plain":    "7",
+		"internal": "x",
+	})
+	want := testUser{
+		testBase: testBase{ID: 42, Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		Name:     "gopher",
+		Timeout:  time.Second,
+		Address:  &testAddress{City: "Berlin", Zip: &zip},
+		Homes:    []testAddress{{City: "Paris"}},
+		Scores:   map[string]int{"go": 10},
+		Tags:     []string{"a", "1"},
+		Extra:    map[string]any{"k": "v"},
+		Plain:    7,
+	}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("StructE() = %+v, %v, want %+v", got, err, want)
+	}
+
+	got, err = StructE[testUser](`{"id": 7, "name": "json"}`)
+	if err != nil || got.ID != 7 || got.Name != "json" {
+		t.Errorf("StructE(JSON) = %+v, %v", got, err)
+	}
+
+	ptr, err := To[*testAddress](map[string]string{"city": "Rome"})
+	if err != nil || ptr == nil || ptr.City != "Rome" {
+		t.Errorf("To[*Address] = %+v, %v", ptr, err)
+	}
+
+	var dst testAddress
+	if err := ConvertInto(&dst, map[any]any{"city": "Oslo", 1: "ignored"}); err != nil || dst.City != "Oslo" {
+		t.Errorf("ConvertInto(&Address) = %+v, %v", dst, err)
+	}
+}
+
+func TestStructE_JSONNumbers(t *testing.T) {
+	got, err := StructE[testBase](`{"id": 9007199254740993}`)
+	if err != nil || got.ID != 9007199254740993 {
+		t.Errorf("StructE() = %+v, %v", got, err)
+	}
+}
+
+func TestStructE_Errors(t *testing.T) {
+	if _, err := StructE[testUser](map[string]any{"id": "abc"}); !errors.Is(err, ErrSyntax) {
+		t.Errorf("StructE(bad field) = %v", err)
+	}
+	if _, err := StructE[testUser](42); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("StructE(int) = %v", err)
+	}
+	if _, err := StructE[testUser](map[int]any{1: 1}); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("StructE(map[int]any) = %v", err)
+	}
+	if v := Struct[testAddress]("not json"); v != (testAddress{}) {
+		t.Errorf("Struct(not json) = %+v", v)
+	}
+}
+
+func TestStringAnyMapE_Struct(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	got, err := StringAnyMapE[string](&testUser{
+		testBase: testBase{ID: 42, Created: created},
+		Address:  &testAddress{City: "Berlin"},
+		Homes:    []testAddress{{City: "Paris"}},
+		Tags:     []string{"a"},
+		Ignored:  "x",
+		Plain:    7,
+	})
+	want := map[string]any{
+		"id":      int64(42),
+		"created": created,
+		"timeout": time.Duration(0),
+		"address": map[string]any{"city": "Berlin", "zip": nil},
+		"homes":   []any{map[string]any{"city": "Paris", "zip": nil}},
+		"scores":  map[string]int(nil),
+		"tags":    []string{"a"},
+		"extra":   map[string]any(nil),
+		"Plain":   uint8(7),
+	}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("StringAnyMapE(struct) = %#v, %v, want %#v", got, err, want)
+	}
+
+	zip := 150
+	strs, err := StringStringMapE[string, string](testAddress{City: "Oslo", Zip: &zip})
+	if err != nil || !reflect.DeepEqual(strs, map[string]string{"city": "Oslo", "zip": "150"}) {
+		t.Errorf("StringStringMapE(struct) = %v, %v", strs, err)
+	}
+
+	strs, err = StringStringMapE[string, string](testAddress{City: "Oslo"})
+	if err != nil || !reflect.DeepEqual(strs, map[string]string{"city": "Oslo", "zip": ""}) {
+		t.Errorf("StringStringMapE(nil pointer field) = %v, %v", strs, err)
+	}
+	ints, err := StringIntMapE[string, int](struct{ Zip *int }{})
+	if err != nil || !reflect.DeepEqual(ints, map[string]int{"Zip": 0}) {
+		t.Errorf("StringIntMapE(nil pointer field) = %v, %v", ints, err)
+	}
+
+	if m, err := StringAnyMapE[string]((*testAddress)(nil)); err != nil || m != nil {
+		t.Errorf("StringAnyMapE(nil pointer) = %#v, %v", m, err)
+	}
+	if m, err := To[map[string]string]((*testAddress)(nil)); err != nil || m != nil {
+		t.Errorf("To[map[string]string](nil pointer) = %#v, %v", m, err)
+	}
+	if s, err := StructE[testAddress]((*testAddress)(nil)); err != nil || s != (testAddress{}) {
+		t.Errorf("StructE(nil pointer) = %#v, %v", s, err)
+	}
+
+	if _, err := StringAnyMapE[string](time.Now()); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("StringAnyMapE(time.Time) = %v", err)
+	}
+}
+
+type testNode struct {
+	Name     string      `json:"name"`
+	Next     *testNode   `json:"next"`
+	Children []*testNode `json:"children"`
+}
+
+func TestStringAnyMapE_Cycle(t *testing.T) {
+	n := &testNode{Name: "a"}
+	n.Next = n
+	var castErr *CastError
+	if _, err := StringAnyMapE[string](n); !errors.Is(err, ErrCycle) || !errors.As(err, &castErr) {
+		t.Errorf("StringAnyMapE(cycle) = %v", err)
+	}
+	if _, err := To[testAddress](n); !errors.Is(err, ErrCycle) {
+		t.Errorf("To(cycle) = %v", err)
+	}
+
+	child := &testNode{Name: "b"}
+	child.Children = []*testNode{n}
+	n.Next = nil
+	n.Children = []*testNode{child}
+	if _, err := StructE[testAddress](n); !errors.Is(err, ErrCycle) {
+		t.Errorf("StructE(cycle through slice) = %v", err)
+	}
+
+	// Values shared without a cycle are encoded each time they occur
+	shared := &testNode{Name: "c"}
+	got, err := StringAnyMapE[string](testNode{Name: "d", Next: shared, Children: []*testNode{shared}})
+	leaf := map[string]any{"name": "c", "next": nil, "children": []*testNode(nil)}
+	want := map[string]any{"name": "d", "next": leaf, "children": []any{leaf}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("StringAnyMapE(shared) = %#v, %v", got, err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -20,6 +21,10 @@ var DefaultTimeFormat = time.RFC3339
 
 // TimeFormats is a list of time formats supported for parsing time strings.
 // It includes common formats like RFC822, RFC850, RFC1123, RFC3339, and several custom formats.
+//
+// Deprecated: Modifying TimeFormats races with concurrent conversions.
+// Its contents are copied into the time format registry on the first time conversion,
+// and later changes have no effect. Use RegisterTimeFormats and SetTimeFormats instead.
 var TimeFormats = []string{
 	time.Layout,
 	time.ANSIC,
@@ -52,6 +57,80 @@ var TimeFormats = []string{
 	"2006-01-02 15:04:05Z0700",                // RFC3339 without T or timezone hh:mm colon
 }
 
+// Timer is implemented by types that can convert themselves to a time.Time.
+// The time converter calls GonvTime before falling back to
+// driver.Valuer and fmt.Stringer.
+//
+// Example:
+//
+//	type Date struct{ year, month, day int }
+//
+//	func (d Date) GonvTime() (time.Time, error) {
+//		return time.Date(d.year, time.Month(d.month), d.day, 0, 0, 0, 0, time.UTC), nil
+//	}
+//
+//	result := Time(Date{2023, 1, 2}) // returns 2023-01-02 00:00:00 UTC
+type Timer interface {
+	GonvTime() (time.Time, error)
+}
+
+// timeFormats holds the current, immutable snapshot of the time format registry.
+// Writers replace the snapshot instead of modifying it, so readers never need a lock.
+var timeFormats atomic.Pointer[[]string]
+
+// CurrentTimeFormats returns a copy of the layouts the package-level time functions
+// try, in order, when parsing time strings.
+//
+// Example:
+//
+//	layouts := CurrentTimeFormats() // returns []string{time.Layout, time.ANSIC, ...}
+func CurrentTimeFormats() []string {
+	return append([]string(nil), loadTimeFormats()...)
+}
+
+// RegisterTimeFormats appends layouts to the time format registry.
+// It is safe to call concurrently with conversions and other registry updates.
+//
+// Example:
+//
+//	RegisterTimeFormats("02/01/2006", "02/01/2006 15:04")
+//	result := Time("31/12/2023") // returns time.Time representing 2023-12-31
+func RegisterTimeFormats(layouts ...string) {
+	for {
+		old := timeFormats.Load()
+		if old == nil {
+			loadTimeFormats()
+			continue
+		}
+		next := append(append(make([]string, 0, len(*old)+len(layouts)), *old...), layouts...)
+		if timeFormats.CompareAndSwap(old, &next) {
+			return
+		}
+	}
+}
+
+// SetTimeFormats replaces the time format registry with layouts.
+// It is safe to call concurrently with conversions and other registry updates.
+//
+// Example:
+//
+//	SetTimeFormats(time.RFC3339, time.DateOnly)
+func SetTimeFormats(layouts ...string) {
+	layouts = append([]string(nil), layouts...)
+	timeFormats.Store(&layouts)
+}
+
+// loadTimeFormats returns the current snapshot of the time format registry,
+// seeding it from TimeFormats on first use. The returned slice must not be modified.
+func loadTimeFormats() []string {
+	if layouts := timeFormats.Load(); layouts != nil {
+		return *layouts
+	}
+	layouts := append([]string(nil), TimeFormats...)
+	timeFormats.CompareAndSwap(nil, &layouts)
+	return *timeFormats.Load()
+}
+
 // Time casts an interface to a time.Time type, ignoring any conversion errors.
 // It returns the zero time value if conversion fails.
 // The time is interpreted in UTC location.
@@ -74,7 +153,7 @@ func Time(o any) time.Time {
 //	result, err := TimeE("2023-01-01T12:00:00Z") // returns time.Time and nil
 //	result, err := TimeE("invalid") // returns zero time and error
 func TimeE(o any) (time.Time, error) {
-	return TimeInLocationE(o, time.UTC)
+	return timeInLocationE(defaultConverter, o, defaultConverter.location)
 }
 
 // TimeInLocation casts an empty interface to time.Time, interpreting inputs without a timezone
@@ -101,43 +180,48 @@ func TimeInLocation(o any, location *time.Location) time.Time {
 //	result, err := TimeInLocationE("2023-01-01 12:00:00", loc) // returns time.Time and nil
 //	result, err := TimeInLocationE("invalid", loc) // returns zero time and error
 func TimeInLocationE(o any, location *time.Location) (time.Time, error) {
-	return timeInLocationE(o, location)
+	return timeInLocationE(defaultConverter, o, location)
 }
 
 // timeInLocationE is the core implementation of time conversion with error handling.
 // It supports multiple input types and tries to parse them using various time formats.
 // The time is interpreted in the given location.
-func timeInLocationE(o any, location *time.Location) (time.Time, error) {
+func timeInLocationE(c *Converter, o any, location *time.Location) (time.Time, error) {
 	var zero time.Time
 	// Handle nil input by returning zero time
 	if o == nil {
 		return zero, nil
 	}
 
+	// Registered conversions take precedence over the built-in ones
+	if r, ok, err := registered[time.Time](o); ok {
+		return r, err
+	}
+
 	// Handle different input types
 	switch t := o.(type) {
-	// String conversion: try parsing with all supported formats
+	// String conversion: try parsing with all time formats of c
 	case string:
-		for _, format := range TimeFormats {
+		for _, format := range c.layouts() {
 			tim, err := time.ParseInLocation(format, t, location)
 			if err != nil {
 				continue
 			}
 			return tim, nil
 		}
-		return failedCastValue[time.Time](o)
+		return failedCastErrValue[time.Time](o, ErrSyntax)
 
 	// Byte slice conversion: convert to string and parse
 	case []byte:
 		ts := string(t)
-		for _, format := range TimeFormats {
+		for _, format := range c.layouts() {
 			tim, err := time.ParseInLocation(format, ts, location)
 			if err != nil {
 				continue
 			}
 			return tim, nil
 		}
-		return failedCastValue[time.Time](o)
+		return failedCastErrValue[time.Time](o, ErrSyntax)
 
 	// Native time.Time type: return as is
 	case time.Time:
@@ -149,13 +233,13 @@ func timeInLocationE(o any, location *time.Location) (time.Time, error) {
 
 	// Protobuf string and bytes wrapper types support
 	case *wrapperspb.StringValue:
-		r, err := timeInLocationE(t.GetValue(), location)
+		r, err := timeInLocationE(c, t.GetValue(), location)
 		if err != nil {
 			return failedCastErrValue[time.Time](o, err)
 		}
 		return r, nil
 	case *wrapperspb.BytesValue:
-		r, err := timeInLocationE(t.GetValue(), location)
+		r, err := timeInLocationE(c, t.GetValue(), location)
 		if err != nil {
 			return failedCastErrValue[time.Time](o, err)
 		}
@@ -171,19 +255,27 @@ func timeInLocationE(o any, location *time.Location) (time.Time, error) {
 		*wrapperspb.Int64Value, *wrapperspb.Int32Value,
 		*wrapperspb.UInt64Value, *wrapperspb.UInt32Value,
 		*wrapperspb.DoubleValue, *wrapperspb.FloatValue:
-		v, err := IntE[int64](t)
+		v, err := intE[int64](c, t)
 		if err != nil {
-			return zero, err
+			return failedCastErrValue[time.Time](o, err)
 		}
 		return time.Unix(v, 0), nil
 
+	// Timer interface support for types that convert themselves to times
+	case Timer:
+		v, err := t.GonvTime()
+		if err != nil {
+			return failedCastErrValue[time.Time](o, err)
+		}
+		return v, nil
+
 	// Database driver.Valuer interface support
 	case driver.Valuer:
 		v, err := t.Value()
 		if err != nil {
 			return failedCastErrValue[time.Time](o, err)
 		}
-		r, err := timeInLocationE(v, location)
+		r, err := timeInLocationE(c, v, location)
 		if err != nil {
 			return failedCastErrValue[time.Time](o, err)
 		}
@@ -191,14 +283,14 @@ func timeInLocationE(o any, location *time.Location) (time.Time, error) {
 
 	// Stringer interface support for custom types that can be represented as strings
 	case fmt.Stringer:
-		for _, format := range TimeFormats {
+		for _, format := range c.layouts() {
 			tim, err := time.ParseInLocation(format, t.String(), location)
 			if err != nil {
 				continue
 			}
 			return tim, nil
 		}
-		return failedCastValue[time.Time](o)
+		return failedCastErrValue[time.Time](o, ErrSyntax)
 
 	// Unsupported types
 	default:
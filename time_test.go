@@ -1,6 +1,7 @@
 package gonv
 
 import (
+	"sync"
 	"testing"
 	"time"
 )
@@ -21,3 +22,52 @@ func TestTimeUnix(t *testing.T) {
 		t.Fatalf("expected unix %d, got %d", ts, tm.Unix())
 	}
 }
+
+func TestTimeFormatsRegistry(t *testing.T) {
+	saved := CurrentTimeFormats()
+	defer SetTimeFormats(saved...)
+
+	if _, err := TimeE("31/12/2023"); err == nil {
+		t.Fatalf("expected unknown layout to fail")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterTimeFormats("02/01/2006")
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = TimeE("2023-12-31T00:00:00Z")
+		}()
+	}
+	wg.Wait()
+
+	if got := len(CurrentTimeFormats()); got != len(saved)+8 {
+		t.Fatalf("expected %d layouts, got %d", len(saved)+8, got)
+	}
+	tm, err := TimeE("31/12/2023")
+	if err != nil || tm.Day() != 31 {
+		t.Fatalf("TimeE with registered layout = %v, %v", tm, err)
+	}
+
+	SetTimeFormats(time.DateOnly)
+	if _, err := TimeE("2023-12-31T00:00:00Z"); err == nil {
+		t.Fatalf("expected replaced layouts to reject RFC3339")
+	}
+}
+
+type testDate struct{ year, month, day int }
+
+func (d testDate) GonvTime() (time.Time, error) {
+	return time.Date(d.year, time.Month(d.month), d.day, 0, 0, 0, 0, time.UTC), nil
+}
+
+func TestTimeE_Timer(t *testing.T) {
+	tm, err := TimeE(testDate{2023, 1, 2})
+	if err != nil || !tm.Equal(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("TimeE(Timer) = %v, %v", tm, err)
+	}
+}
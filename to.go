@@ -0,0 +1,360 @@
+package gonv
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// To converts an interface to the type T, returning both the converted value and any error encountered.
+// The converter is chosen by the kind of T, so To works where T is only known as a type parameter.
+// Named types such as `type Port uint16` are converted through their underlying kind,
+// slices and maps are converted element by element, and time.Time and time.Duration are supported.
+// An error wrapping ErrUnsupported is returned for any other type.
+//
+// Example:
+//
+//	type Port uint16
+//
+//	result, err := To[Port]("8080")                        // returns Port(8080), nil
+//	result, err := To[[]int]([]string{"1", "2"})           // returns []int{1, 2}, nil
+//	result, err := To[map[string]int](`{"a":1}`)           // returns map[string]int{"a": 1}, nil
+//	result, err := To[time.Duration]("1m")                 // returns time.Minute, nil
+//	result, err := To[chan int](1)                         // returns nil, error
+func To[T any](o any) (T, error) {
+	var zero T
+	v, err := convertTo(defaultConverter, o, typeOf[T]())
+	if err != nil {
+		return zero, err
+	}
+	r, _ := v.Interface().(T)
+	return r, nil
+}
+
+// ConvertToType converts an interface to the type t, returning the converted value and any error encountered.
+// It is the reflection counterpart of To for target types only known at runtime,
+// such as parameter types found by reflecting over function signatures.
+// In addition to the types supported by To, pointer targets are allocated and filled with the converted value,
+// and array targets are filled element by element. Pointer inputs to slices, arrays and maps are dereferenced.
+// The returned value is always valid and assignable to t; it holds the zero value of t on error.
+//
+// Example:
+//
+//	v, err := ConvertToType("8080", reflect.TypeOf(uint16(0)))          // returns reflect.ValueOf(uint16(8080)), nil
+//	v, err := ConvertToType("42", reflect.TypeOf((*int)(nil)))          // returns a reflect.Value holding a *int pointing to 42, nil
+//	v, err := ConvertToType([]string{"1", "2"}, reflect.TypeOf([2]int{})) // returns reflect.ValueOf([2]int{1, 2}), nil
+func ConvertToType(o any, t reflect.Type) (reflect.Value, error) {
+	return convertTo(defaultConverter, o, t)
+}
+
+// ConvertInto converts src to the type dst points to and stores the result in *dst.
+// Nil pointers between dst and the final target are allocated as needed, while existing ones are reused,
+// so a **int, *[]string or *map[string]int can be filled without knowing its type.
+// dst is left unchanged if the conversion fails, except for the partial results of the
+// InvalidElementsSkip and InvalidElementsReplace modes, which are stored along with their error.
+// An error wrapping ErrNil is returned if dst is a nil pointer, and one wrapping ErrUnsupported if dst is not a pointer.
+//
+// Example:
+//
+//	var port *uint16
+//	err := ConvertInto(&port, "8080") // port points to 8080, err = nil
+//	var tags []string
+//	err := ConvertInto(&tags, []any{"a", 1}) // tags = []string{"a", "1"}, err = nil
+func ConvertInto(dst any, src any) error {
+	return convertInto(defaultConverter, dst, src)
+}
+
+// convertInto is the core implementation of ConvertInto.
+// Pointers are followed until the type of src or a non-pointer type is reached,
+// so that a src of type *T stored into a **T keeps its identity.
+func convertInto(c *Converter, dst any, src any) error {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Pointer {
+		return castError(src, reflect.TypeOf(dst), ErrUnsupported)
+	}
+	if d.IsNil() {
+		return castError(src, d.Type(), ErrNil)
+	}
+	srcType := reflect.TypeOf(src)
+	t := d.Type().Elem()
+	for t.Kind() == reflect.Pointer && t != srcType && src != nil {
+		t = t.Elem()
+	}
+	v, err := convertTo(c, src, t)
+	if err != nil && !c.partial(err) {
+		return err
+	}
+	target := d.Elem()
+	for target.Type() != t {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		target = target.Elem()
+	}
+	target.Set(v)
+	return err
+}
+
+// convertTo is the core implementation of conversion to a type only known at runtime.
+// It dispatches to the converter for the kind of t and converts the result to t,
+// so named types share the converters of their underlying kind.
+// The returned value is always valid and of type t, the zero value of t on error.
+func convertTo(c *Converter, o any, t reflect.Type) (reflect.Value, error) {
+	// Registered conversions take precedence over the built-in ones
+	if v, ok, err := registeredValue(o, t); ok {
+		return v, err
+	}
+
+	// Types with a dedicated converter
+	switch t {
+	case timeType:
+		return valueOf(func(c *Converter, o any) (time.Time, error) {
+			return timeInLocationE(c, o, c.location)
+		})(c, o, t)
+	case durationType:
+		return valueOf(durationE)(c, o, t)
+	}
+
+	// Kinds with a generic converter, instantiated for the underlying type
+	switch t.Kind() {
+	case reflect.Bool:
+		return valueOf(boolE[bool])(c, o, t)
+	case reflect.Int:
+		return valueOf(intE[int])(c, o, t)
+	case reflect.Int8:
+		return valueOf(intE[int8])(c, o, t)
+	case reflect.Int16:
+		return valueOf(intE[int16])(c, o, t)
+	case reflect.Int32:
+		return valueOf(intE[int32])(c, o, t)
+	case reflect.Int64:
+		return valueOf(intE[int64])(c, o, t)
+	case reflect.Uint:
+		return valueOf(uintE[uint])(c, o, t)
+	case reflect.Uint8:
+		return valueOf(uintE[uint8])(c, o, t)
+	case reflect.Uint16:
+		return valueOf(uintE[uint16])(c, o, t)
+	case reflect.Uint32:
+		return valueOf(uintE[uint32])(c, o, t)
+	case reflect.Uint64:
+		return valueOf(uintE[uint64])(c, o, t)
+	case reflect.Uintptr:
+		return valueOf(uintE[uintptr])(c, o, t)
+	case reflect.Float32:
+		return valueOf(floatE[float32])(c, o, t)
+	case reflect.Float64:
+		return valueOf(floatE[float64])(c, o, t)
+	case reflect.String:
+		return valueOf(stringE[string])(c, o, t)
+	case reflect.Pointer:
+		return convertToPointer(c, o, t)
+	case reflect.Slice:
+		return convertToSlice(c, o, t)
+	case reflect.Array:
+		return convertToArray(c, o, t)
+	case reflect.Map:
+		return convertToMap(c, o, t)
+	case reflect.Struct:
+		return convertToStruct(c, o, t)
+	case reflect.Interface:
+		// Interface types accept any value implementing them
+		if o == nil {
+			return reflect.Zero(t), nil
+		}
+		if reflect.TypeOf(o).Implements(t) {
+			return reflect.ValueOf(o).Convert(t), nil
+		}
+	}
+
+	// Values that already have the target type
+	if o != nil && reflect.TypeOf(o) == t {
+		return reflect.ValueOf(o), nil
+	}
+	return reflect.Zero(t), castError(o, t, ErrUnsupported)
+}
+
+// convertToPointer converts o to the element type of the pointer type t and returns a pointer to the result.
+// Values that already have the type t are returned unchanged, and nil values convert to a nil pointer.
+func convertToPointer(c *Converter, o any, t reflect.Type) (reflect.Value, error) {
+	v := reflect.ValueOf(o)
+	if o == nil || v.Kind() == reflect.Pointer && v.IsNil() {
+		return reflect.Zero(t), nil
+	}
+	if v.Type() == t {
+		return v, nil
+	}
+	elem, err := convertTo(c, o, t.Elem())
+	if err != nil && !c.partial(err) {
+		return reflect.Zero(t), retarget(err, o, t)
+	}
+	res := reflect.New(t.Elem())
+	res.Elem().Set(elem)
+	return res, err
+}
+
+// indirect dereferences o if it is a pointer, so that composite targets accept pointers to their sources.
+// It reports false if o is a nil pointer.
+func indirect(o any) (any, bool) {
+	v := reflect.ValueOf(o)
+	if v.Kind() != reflect.Pointer {
+		return o, true
+	}
+	v = indirectValue(v)
+	if v.Kind() == reflect.Pointer {
+		return nil, false
+	}
+	return v.Interface(), true
+}
+
+// convertToSlice converts o to the slice type t with toSliceE, converting each element to the element type of t.
+func convertToSlice(c *Converter, o any, t reflect.Type) (reflect.Value, error) {
+	o, ok := indirect(o)
+	if !ok {
+		return reflect.Zero(t), nil
+	}
+	// Byte slices are copied from strings and byte slices as a whole
+	if t.Elem().Kind() == reflect.Uint8 {
+		switch b := o.(type) {
+		case string:
+			return reflect.ValueOf([]byte(b)).Convert(t), nil
+		case []byte:
+			return reflect.ValueOf(append([]byte(nil), b...)).Convert(t), nil
+		}
+	}
+	elems, err := toSliceE[[]reflect.Value](c, o, func(o any) (reflect.Value, error) {
+		return convertTo(c, o, t.Elem())
+	})
+	if err != nil && !c.partial(err) {
+		return reflect.Zero(t), retarget(err, o, t)
+	}
+	if elems == nil {
+		return reflect.Zero(t), err
+	}
+	res := reflect.MakeSlice(t, len(elems), len(elems))
+	for i, elem := range elems {
+		// Replaced elements are invalid if no replacement could be converted, leaving them zero
+		if elem.IsValid() {
+			res.Index(i).Set(elem)
+		}
+	}
+	return res, err
+}
+
+// convertToArray converts o to the array type t by converting it to a slice of the element type of t.
+// Shorter inputs leave the remaining elements zero, longer inputs are rejected with ErrOverflow.
+func convertToArray(c *Converter, o any, t reflect.Type) (reflect.Value, error) {
+	elems, err := convertToSlice(c, o, reflect.SliceOf(t.Elem()))
+	if err != nil && !c.partial(err) {
+		return reflect.Zero(t), retarget(err, o, t)
+	}
+	if elems.Len() > t.Len() {
+		return reflect.Zero(t), castError(o, t, ErrOverflow)
+	}
+	res := reflect.New(t).Elem()
+	reflect.Copy(res, elems)
+	return res, err
+}
+
+// convertToMap converts o to the map type t with mapE, converting each key and value to the key and value types of t.
+func convertToMap(c *Converter, o any, t reflect.Type) (reflect.Value, error) {
+	o, ok := indirect(o)
+	if !ok {
+		return reflect.Zero(t), nil
+	}
+	// Strings are unmarshaled as JSON into t directly, as mapE does for its own map type,
+	// falling back to converting the members of the object or the delimited pairs one by one
+	if s, ok := o.(string); ok {
+		res := reflect.New(t)
+		err := json.Unmarshal([]byte(s), res.Interface())
+		if err == nil {
+			return res.Elem(), nil
+		}
+		entries, err := stringEntries(c, s)
+		if err != nil {
+			return reflect.Zero(t), castError(o, t, err)
+		}
+		pairs := make([][2]any, len(entries))
+		for i, e := range entries {
+			pairs[i] = [2]any{e.key, e.val}
+		}
+		o = pairs
+	}
+	// mapE keeps only the first value of each key of multi-valued maps when converting to map[any]any,
+	// so they are passed as plain maps when the values of t hold all values
+	switch m := o.(type) {
+	case url.Values:
+		if isMultiValued(t.Elem()) {
+			o = map[string][]string(m)
+		}
+	case http.Header:
+		if isMultiValued(t.Elem()) {
+			o = map[string][]string(m)
+		}
+	}
+	pairs, err := mapE[map[any]any](c, o,
+		func(o any) (any, error) {
+			k, err := convertTo(c, o, t.Key())
+			return k.Interface(), err
+		},
+		func(o any) (any, error) {
+			v, err := convertTo(c, o, t.Elem())
+			return v.Interface(), err
+		},
+	)
+	if err != nil && !c.partial(err) {
+		return reflect.Zero(t), retarget(err, o, t)
+	}
+	if pairs == nil {
+		return reflect.Zero(t), err
+	}
+	res := reflect.MakeMapWithSize(t, len(pairs))
+	for k, v := range pairs {
+		res.SetMapIndex(valueOrZero(k, t.Key()), valueOrZero(v, t.Elem()))
+	}
+	return res, err
+}
+
+// valueOf adapts the converter conv of an underlying type to convert to a type only known at runtime.
+// The result of conv is converted to t, or on error the zero value of t is returned
+// with the error retargeted to t.
+//
+// Example:
+//
+//	v, err := valueOf(intE[int])(c, o, t)
+func valueOf[E any](conv func(c *Converter, o any) (E, error)) func(c *Converter, o any, t reflect.Type) (reflect.Value, error) {
+	return func(c *Converter, o any, t reflect.Type) (reflect.Value, error) {
+		v, err := conv(c, o)
+		if err != nil {
+			return reflect.Zero(t), retarget(err, o, t)
+		}
+		return reflect.ValueOf(v).Convert(t), nil
+	}
+}
+
+// valueOrZero returns the reflect.Value of v, or the zero value of t if v is nil.
+func valueOrZero(v any, t reflect.Type) reflect.Value {
+	if v == nil {
+		return reflect.Zero(t)
+	}
+	return reflect.ValueOf(v)
+}
+
+// retarget reports a failure to convert o, returned by the converter of the underlying type of t,
+// as a failure to convert o to t. Failures of nested values are returned unchanged.
+func retarget(err error, o any, t reflect.Type) error {
+	castErr, ok := err.(*CastError)
+	if !ok || castErr.To == t || castErr.Path != "" || castErr.From != reflect.TypeOf(o) {
+		return err
+	}
+	r := *castErr
+	r.To = t
+	return &r
+}
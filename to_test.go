@@ -0,0 +1,168 @@
+package gonv
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type (
+	testPort   uint16
+	testPorts  []testPort
+	testLevels map[string]int
+)
+
+func TestTo(t *testing.T) {
+	if v, err := To[testPort]("8080"); err != nil || v != 8080 {
+		t.Errorf("To[Port] = %v, %v", v, err)
+	}
+	if v, err := To[int64](42.0); err != nil || v != 42 {
+		t.Errorf("To[int64] = %v, %v", v, err)
+	}
+	if v, err := To[bool]("true"); err != nil || !v {
+		t.Errorf("To[bool] = %v, %v", v, err)
+	}
+	if v, err := To[string](3.5); err != nil || v != "3.5" {
+		t.Errorf("To[string] = %v, %v", v, err)
+	}
+	if v, err := To[[]int]([]string{"1", "2"}); err != nil || !reflect.DeepEqual(v, []int{1, 2}) {
+		t.Errorf("To[[]int] = %v, %v", v, err)
+	}
+	if v, err := To[testPorts]([]any{"80", 443}); err != nil || !reflect.DeepEqual(v, testPorts{80, 443}) {
+		t.Errorf("To[Ports] = %v, %v", v, err)
+	}
+	if v, err := To[[][]float64]([][]string{{"1.5"}, {"2", "3"}}); err != nil || !reflect.DeepEqual(v, [][]float64{{1.5}, {2, 3}}) {
+		t.Errorf("To[[][]float64] = %v, %v", v, err)
+	}
+	if v, err := To[[]byte]("abc"); err != nil || string(v) != "abc" {
+		t.Errorf("To[[]byte] = %v, %v", v, err)
+	}
+	if v, err := To[testLevels](`{"debug":0,"info":1}`); err != nil || !reflect.DeepEqual(v, testLevels{"debug": 0, "info": 1}) {
+		t.Errorf("To[Levels] = %v, %v", v, err)
+	}
+	if v, err := To[time.Duration]("1m"); err != nil || v != time.Minute {
+		t.Errorf("To[time.Duration] = %v, %v", v, err)
+	}
+	if v, err := To[time.Time]("2024-01-02T03:04:05Z"); err != nil || !v.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("To[time.Time] = %v, %v", v, err)
+	}
+	if v, err := To[any](42); err != nil || v != 42 {
+		t.Errorf("To[any] = %v, %v", v, err)
+	}
+	if v, err := To[struct{ A int }](struct{ A int }{1}); err != nil || v.A != 1 {
+		t.Errorf("To[struct] = %v, %v", v, err)
+	}
+}
+
+func TestTo_Errors(t *testing.T) {
+	var castErr *CastError
+	_, err := To[testPort]("70000")
+	if !errors.Is(err, ErrOverflow) || !errors.As(err, &castErr) || castErr.To != reflect.TypeOf(testPort(0)) {
+		t.Errorf("To[Port] overflow = %v", err)
+	}
+	_, err = To[chan int](1)
+	if !errors.Is(err, ErrUnsupported) {
+		t.Errorf("To[chan int] = %v", err)
+	}
+	_, err = To[testPorts]([]string{"80", "x"})
+	if !errors.Is(err, ErrSyntax) || !errors.As(err, &castErr) || castErr.Value != "x" {
+		t.Errorf("To[Ports] element error = %v", err)
+	}
+}
+
+func TestConvertToType(t *testing.T) {
+	v, err := ConvertToType("8080", reflect.TypeOf(testPort(0)))
+	if err != nil || v.Interface() != testPort(8080) {
+		t.Errorf("ConvertToType(Port) = %v, %v", v, err)
+	}
+	v, err = ConvertToType("42", reflect.TypeOf((*int)(nil)))
+	if err != nil || *v.Interface().(*int) != 42 {
+		t.Errorf("ConvertToType(*int) = %v, %v", v, err)
+	}
+	v, err = ConvertToType(nil, reflect.TypeOf((*int)(nil)))
+	if err != nil || !v.IsNil() {
+		t.Errorf("ConvertToType(nil, *int) = %v, %v", v, err)
+	}
+	v, err = ConvertToType([]string{"1", "2"}, reflect.TypeOf([3]int{}))
+	if err != nil || v.Interface() != [3]int{1, 2, 0} {
+		t.Errorf("ConvertToType([3]int) = %v, %v", v, err)
+	}
+	v, err = ConvertToType(&[]string{"1", "2"}, reflect.TypeOf([]*int64{}))
+	if err != nil || v.Len() != 2 || *v.Index(1).Interface().(*int64) != 2 {
+		t.Errorf("ConvertToType([]*int64) = %v, %v", v, err)
+	}
+	v, err = ConvertToType(`{"a":1}`, reflect.TypeOf(testLevels{}))
+	if err != nil || !reflect.DeepEqual(v.Interface(), testLevels{"a": 1}) {
+		t.Errorf("ConvertToType(Levels) = %v, %v", v, err)
+	}
+
+	_, err = ConvertToType([]int{1, 2, 3}, reflect.TypeOf([2]int{}))
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("ConvertToType([2]int) = %v", err)
+	}
+	v, err = ConvertToType("x", reflect.TypeOf((*int)(nil)))
+	var castErr *CastError
+	if !errors.Is(err, ErrSyntax) || !errors.As(err, &castErr) || castErr.To != reflect.TypeOf((*int)(nil)) || !v.IsNil() {
+		t.Errorf("ConvertToType(x, *int) = %v, %v", v, err)
+	}
+}
+
+func TestConverter_ConvertToType(t *testing.T) {
+	c := New(WithStrict())
+	if _, err := c.ConvertToType(true, reflect.TypeOf(0)); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("strict ConvertToType(true, int) = %v", err)
+	}
+}
+
+func TestConvertInto(t *testing.T) {
+	var port *testPort
+	if err := ConvertInto(&port, "8080"); err != nil || port == nil || *port != 8080 {
+		t.Errorf("ConvertInto(*Port) = %v, %v", port, err)
+	}
+
+	n := 1
+	pn := &n
+	if err := ConvertInto(&pn, "2"); err != nil || pn != &n || n != 2 {
+		t.Errorf("ConvertInto(**int) did not reuse the existing pointer: %v, %v", n, err)
+	}
+
+	var ppn **int
+	if err := ConvertInto(&ppn, 3.0); err != nil || **ppn != 3 {
+		t.Errorf("ConvertInto(***int) = %v", err)
+	}
+
+	var tags *[]string
+	if err := ConvertInto(&tags, []any{"a", 1}); err != nil || !reflect.DeepEqual(*tags, []string{"a", "1"}) {
+		t.Errorf("ConvertInto(*[]string) = %v, %v", tags, err)
+	}
+
+	var counts *map[string]int
+	if err := ConvertInto(&counts, `{"a":1}`); err != nil || (*counts)["a"] != 1 {
+		t.Errorf("ConvertInto(*map[string]int) = %v, %v", counts, err)
+	}
+
+	m := 7
+	if err := ConvertInto(&pn, &m); err != nil || pn != &m {
+		t.Errorf("ConvertInto(**int, *int) = %v, %v", pn, err)
+	}
+
+	var d time.Duration
+	if err := ConvertInto(&d, "1s"); err != nil || d != time.Second {
+		t.Errorf("ConvertInto(*time.Duration) = %v, %v", d, err)
+	}
+}
+
+func TestConvertInto_Errors(t *testing.T) {
+	var i int
+	if err := ConvertInto(i, "1"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("ConvertInto(non-pointer) = %v", err)
+	}
+	if err := ConvertInto((*int)(nil), "1"); !errors.Is(err, ErrNil) {
+		t.Errorf("ConvertInto(nil pointer) = %v", err)
+	}
+	var p *int
+	if err := ConvertInto(&p, "x"); !errors.Is(err, ErrSyntax) || p != nil {
+		t.Errorf("ConvertInto(x) = %v, %v", p, err)
+	}
+}
@@ -3,9 +3,12 @@ package gonv
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"golang.org/x/exp/constraints"
@@ -35,8 +38,10 @@ func Uint[E constraints.Unsigned](o any) E {
 //
 //	result, err := UintE[uint64]("42") // returns 42, nil
 //	result, err := UintE[uint64]("-1") // returns 0, error (negative values are not allowed)
+//	result, err := UintE[uint8](256) // returns 0, error (value out of range of uint8)
+//	result, err := UintE[uint]("19.99") // returns 0, error (fractional strings are only rounded with WithRounding)
 func UintE[E constraints.Unsigned](o any) (E, error) {
-	return uintE[E](o)
+	return uintE[E](defaultConverter, o)
 }
 
 // UintS converts an interface to an unsigned integer slice type, ignoring any conversion errors.
@@ -47,8 +52,8 @@ func UintE[E constraints.Unsigned](o any) (E, error) {
 // Example:
 //
 //	result := UintS[[]uint64, uint64]([]string{"1", "2", "3"}) // returns []uint64{1, 2, 3}
-func UintS[S ~[]E, E constraints.Unsigned](o any) S {
-	v, _ := UintSE[S](o)
+func UintS[S ~[]E, E constraints.Unsigned](o any, opts ...Option) S {
+	v, _ := UintSE[S](o, opts...)
 	return v
 }
 
@@ -56,184 +61,132 @@ func UintS[S ~[]E, E constraints.Unsigned](o any) S {
 // This function is useful when you need to handle conversion errors for slice data explicitly.
 // S is a slice type with elements of unsigned integer type.
 // E must be an unsigned integer type (uint, uint8, uint16, uint32, uint64).
+// opts apply Converter options, such as WithInvalidElements, to this conversion only.
 //
 // Example:
 //
 //	result, err := UintSE[[]uint64, uint64]([]string{"1", "2"}) // returns []uint64{1, 2}, nil
 //	result, err := UintSE[[]uint64, uint64]([]string{"1", "-1"}) // returns nil, error (negative values are not allowed)
-func UintSE[S ~[]E, E constraints.Unsigned](o any) (S, error) {
-	return toSliceE[S](o, uintE[E])
+func UintSE[S ~[]E, E constraints.Unsigned](o any, opts ...Option) (S, error) {
+	c := converterWith(opts)
+	return toSliceE[S](c, o, bind(c, uintE[E]))
 }
 
 // uintE is the core implementation of unsigned integer conversion with error handling.
 // It uses a fast path approach for common types and falls back to reflection for complex types.
 // E must be an unsigned integer type (uint, uint8, uint16, uint32, uint64).
 // Negative values are not allowed and will result in an error.
-func uintE[E constraints.Unsigned](o any) (E, error) {
+func uintE[E constraints.Unsigned](c *Converter, o any) (E, error) {
 	var zero E
 	// Handle nil input by returning zero value
 	if o == nil {
 		return zero, nil
 	}
 
+	// Registered conversions take precedence over the built-in ones
+	if r, ok, err := registered[E](o); ok {
+		return r, err
+	}
+
 	// Fast path: direct type assertions for common types
 	switch u := o.(type) {
-	// Boolean conversion: true becomes 1, false becomes 0
+	// Boolean conversion: true becomes 1, false becomes 0; rejected by strict converters
 	case bool:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		if u {
 			return 1, nil
 		}
 		return zero, nil
 
-	// Signed integer types: check for negative values
+	// Signed integer types: check for negative values and the range of E
 	case int:
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromInt[E](c, o, int64(u))
 	case int64:
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromInt[E](c, o, u)
 	case int32:
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromInt[E](c, o, int64(u))
 	case int16:
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromInt[E](c, o, int64(u))
 	case int8:
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromInt[E](c, o, int64(u))
 
-	// Unsigned integer types: direct conversion
+	// Unsigned integer types: conversion checked against the range of E
 	case uint:
-		return E(u), nil
+		return unsignedFromUint[E](c, o, uint64(u))
 	case uint64:
-		return E(u), nil
+		return unsignedFromUint[E](c, o, u)
 	case uint32:
-		return E(u), nil
+		return unsignedFromUint[E](c, o, uint64(u))
 	case uint16:
-		return E(u), nil
+		return unsignedFromUint[E](c, o, uint64(u))
 	case uint8:
-		return E(u), nil
+		return unsignedFromUint[E](c, o, uint64(u))
 
-	// Floating-point types: check for negative values
+	// Floating-point types: check for negative values and the range of E
 	case float64:
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromFloat[E](c, o, u)
 	case float32:
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromFloat[E](c, o, float64(u))
 
 	// String conversion using strconv.ParseUint with trimZeroDecimal
 	case string:
-		v, err := strconv.ParseUint(trimZeroDecimal(u), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseUnsigned[E](c, o, u)
 
 	// Byte slice conversion by converting to string first
 	case []byte:
-		v, err := strconv.ParseUint(trimZeroDecimal(string(u)), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseUnsigned[E](c, o, string(u))
 
 	// Time types that can be converted to numeric values
 	case time.Duration:
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromInt[E](c, o, int64(u))
 	case time.Weekday:
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromInt[E](c, o, int64(u))
 	case time.Month:
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromInt[E](c, o, int64(u))
 
 	// JSON number support
 	case json.Number:
-		v, err := u.Int64()
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		if v < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(v), err
+		return parseUnsigned[E](c, o, u.String())
 
 	// Protobuf duration type support: convert to duration then check for negative values
 	case *durationpb.Duration:
-		v := u.AsDuration()
-		if v < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(v), nil
+		return unsignedFromInt[E](c, o, int64(u.AsDuration()))
 
 	// Protobuf wrapper types support
 	case *wrapperspb.BoolValue:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		if u.GetValue() {
 			return 1, nil
 		}
 		return zero, nil
 	case *wrapperspb.Int64Value:
-		v := u.GetValue()
-		if v < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(v), nil
+		return unsignedFromInt[E](c, o, u.GetValue())
 	case *wrapperspb.Int32Value:
-		v := u.GetValue()
-		if v < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(v), nil
+		return unsignedFromInt[E](c, o, int64(u.GetValue()))
 	case *wrapperspb.UInt64Value:
-		return E(u.GetValue()), nil
+		return unsignedFromUint[E](c, o, u.GetValue())
 	case *wrapperspb.UInt32Value:
-		return E(u.GetValue()), nil
+		return unsignedFromUint[E](c, o, uint64(u.GetValue()))
 	case *wrapperspb.DoubleValue:
-		v := u.GetValue()
-		if v < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(v), nil
+		return unsignedFromFloat[E](c, o, u.GetValue())
 	case *wrapperspb.FloatValue:
-		v := u.GetValue()
-		if v < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(v), nil
+		return unsignedFromFloat[E](c, o, float64(u.GetValue()))
 	case *wrapperspb.StringValue:
-		v, err := strconv.ParseUint(trimZeroDecimal(u.GetValue()), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseUnsigned[E](c, o, u.GetValue())
 	case *wrapperspb.BytesValue:
-		v, err := strconv.ParseUint(trimZeroDecimal(string(u.GetValue())), 0, 0)
+		return parseUnsigned[E](c, o, string(u.GetValue()))
+
+	// Inter interface support for types that convert themselves to integers
+	case Inter:
+		v, err := u.GonvInt64()
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
-		return E(v), nil
+		return unsignedFromInt[E](c, o, v)
 
 	// Database driver.Valuer interface support
 	case driver.Valuer:
@@ -241,7 +194,7 @@ func uintE[E constraints.Unsigned](o any) (E, error) {
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
-		r, err := uintE[E](v)
+		r, err := uintE[E](c, v)
 		if err != nil {
 			return failedCastErrValue[E](o, err)
 		}
@@ -249,15 +202,11 @@ func uintE[E constraints.Unsigned](o any) (E, error) {
 
 	// Stringer interface support for custom types that can be represented as strings
 	case fmt.Stringer:
-		v, err := strconv.ParseUint(trimZeroDecimal(string(u.String())), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(v), nil
+		return parseUnsigned[E](c, o, u.String())
 
 	// Default case: use reflection-based conversion for complex types
 	default:
-		return toUnsignedValueE[E](o)
+		return toUnsignedValueE[E](c, o)
 	}
 }
 
@@ -265,47 +214,38 @@ func uintE[E constraints.Unsigned](o any) (E, error) {
 // It's used when fast path type assertions fail and more complex type analysis is needed.
 // E must be an unsigned integer type (uint, uint8, uint16, uint32, uint64).
 // Negative values are not allowed and will result in an error.
-func toUnsignedValueE[E constraints.Unsigned](o any) (E, error) {
+func toUnsignedValueE[E constraints.Unsigned](c *Converter, o any) (E, error) {
 	// Get the underlying value, dereferencing pointers if necessary
 	v := indirectValue(reflect.ValueOf(o))
 	var zero E
 
 	// Handle different reflection kinds
 	switch v.Kind() {
-	// Boolean conversion: true becomes 1, false becomes 0
+	// Boolean conversion: true becomes 1, false becomes 0; rejected by strict converters
 	case reflect.Bool:
+		if c.strict {
+			return failedCastValue[E](o)
+		}
 		if v.Bool() {
 			return 1, nil
 		}
 		return zero, nil
 
-	// Signed integer types: check for negative values
+	// Signed integer types: check for negative values and the range of E
 	case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
-		u := v.Int()
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromInt[E](c, o, v.Int())
 
-	// Unsigned integer types: direct conversion
+	// Unsigned integer types: conversion checked against the range of E
 	case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8:
-		return E(v.Uint()), nil
+		return unsignedFromUint[E](c, o, v.Uint())
 
-	// Floating-point types: check for negative values
+	// Floating-point types: check for negative values and the range of E
 	case reflect.Float64, reflect.Float32:
-		u := v.Float()
-		if u < 0 {
-			return failedCastValue[E](o)
-		}
-		return E(u), nil
+		return unsignedFromFloat[E](c, o, v.Float())
 
 	// String conversion using strconv.ParseUint with trimZeroDecimal
 	case reflect.String:
-		u, err := strconv.ParseUint(trimZeroDecimal(v.String()), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(u), nil
+		return parseUnsigned[E](c, o, v.String())
 
 	// Byte slice conversion (must be []byte)
 	case reflect.Slice:
@@ -313,14 +253,133 @@ func toUnsignedValueE[E constraints.Unsigned](o any) (E, error) {
 		if v.Type().Elem().Kind() != reflect.Uint8 {
 			return failedCastValue[E](o)
 		}
-		u, err := strconv.ParseUint(trimZeroDecimal(string(v.Bytes())), 0, 0)
-		if err != nil {
-			return failedCastErrValue[E](o, err)
-		}
-		return E(u), nil
+		return parseUnsigned[E](c, o, string(v.Bytes()))
+
+	// Nil pointers that cannot be dereferenced
+	case reflect.Pointer:
+		return failedCastErrValue[E](o, ErrNil)
 
 	// Unsupported types
 	default:
 		return failedCastValue[E](o)
 	}
 }
+
+// unsignedFromInt converts the int64 value v, taken from o, to E.
+// Negative values and values that do not fit in E are handled by the overflow policy of c;
+// an error wrapping ErrNegative or ErrOverflow is returned if the policy rejects them.
+func unsignedFromInt[E constraints.Unsigned](c *Converter, o any, v int64) (E, error) {
+	if v < 0 {
+		return unsignedNegative[E](c, o)
+	}
+	return unsignedFromUint[E](c, o, uint64(v))
+}
+
+// unsignedFromUint converts the uint64 value v, taken from o, to E.
+// Values that do not fit in E are handled by the overflow policy of c;
+// an error wrapping ErrOverflow is returned if the policy rejects them.
+func unsignedFromUint[E constraints.Unsigned](c *Converter, o any, v uint64) (E, error) {
+	if v > maxUnsigned[E]() {
+		return unsignedOverflow[E](c, o, v)
+	}
+	return E(v), nil
+}
+
+// unsignedFromFloat converts the float64 value v, taken from o, to E using the rounding policy of c.
+// NaN and infinite values are handled by the NaN and infinity policy of c,
+// and negative values and values that do not fit in E by the overflow policy of c.
+// It returns an error wrapping ErrNonFinite, ErrNegative, ErrPrecision or ErrOverflow if a policy rejects v.
+func unsignedFromFloat[E constraints.Unsigned](c *Converter, o any, v float64) (E, error) {
+	if !isFinite(v) {
+		sign, ok := c.nonFiniteSign(v)
+		switch {
+		case !ok:
+			return failedCastErrValue[E](o, ErrNonFinite)
+		case sign > 0:
+			return E(maxUnsigned[E]()), nil
+		default:
+			return 0, nil
+		}
+	}
+	if v < 0 {
+		return unsignedNegative[E](c, o)
+	}
+	r, exact := c.round(v)
+	if !exact {
+		return failedCastErrValue[E](o, ErrPrecision)
+	}
+	// 2^n is the first value past the largest n-bit unsigned integer and is exact in float64.
+	if r >= math.Ldexp(1, bitSize[E]()) {
+		return unsignedOverflow[E](c, o, uint64(wrapFloat(r)))
+	}
+	return E(r), nil
+}
+
+// unsignedNegative applies the overflow policy of c to the negative value o.
+// Saturation yields zero; both other policies reject o with an error wrapping ErrNegative.
+func unsignedNegative[E constraints.Unsigned](c *Converter, o any) (E, error) {
+	if c.overflow == OverflowSaturate {
+		return 0, nil
+	}
+	return failedCastErrValue[E](o, ErrNegative)
+}
+
+// unsignedOverflow applies the overflow policy of c to the value v, taken from o, that does not fit in E.
+func unsignedOverflow[E constraints.Unsigned](c *Converter, o any, v uint64) (E, error) {
+	switch c.overflow {
+	case OverflowWrap:
+		return E(v), nil
+	case OverflowSaturate:
+		return E(maxUnsigned[E]()), nil
+	default:
+		return failedCastErrValue[E](o, ErrOverflow)
+	}
+}
+
+// parseUnsigned parses the string s, taken from o, as an unsigned integer in the base of c and converts it to E.
+// Strings that only parse as floating-point numbers, such as "2.5", are rounded using the policy of c
+// if it was set with WithRounding, and rejected otherwise unless they are integral, such as "1e3".
+// Negative numbers and numbers that do not fit in E are handled by the overflow policy of c.
+func parseUnsigned[E constraints.Unsigned](c *Converter, o any, s string) (E, error) {
+	v, err := parseUint(trimZeroDecimal(s), c.base, 64)
+	switch {
+	case err == nil:
+		return unsignedFromUint[E](c, o, v)
+	case errors.Is(err, ErrNegative):
+		return unsignedNegative[E](c, o)
+	case errors.Is(err, strconv.ErrRange) && c.overflow == OverflowSaturate:
+		// ParseUint returns the largest uint64 on range errors.
+		return unsignedFromUint[E](c, o, v)
+	case errors.Is(err, strconv.ErrRange) && c.overflow == OverflowWrap:
+		if bits, ok := wrapString(trimZeroDecimal(s), c.base); ok {
+			return unsignedFromUint[E](c, o, bits)
+		}
+	case errors.Is(err, strconv.ErrSyntax):
+		f, ferr := strconv.ParseFloat(s, 64)
+		switch {
+		case ferr == nil && (c.rounded || f == math.Trunc(f) || f < 0):
+			return unsignedFromFloat[E](c, o, f)
+		case errors.Is(ferr, strconv.ErrRange) && math.IsInf(f, -1):
+			return unsignedNegative[E](c, o)
+		case errors.Is(ferr, strconv.ErrRange) && math.IsInf(f, 1):
+			// Numbers beyond the range of float64, such as "1e400", are beyond the range of E too
+			if c.overflow == OverflowSaturate {
+				return unsignedFromFloat[E](c, o, math.MaxFloat64)
+			}
+			err = ferr
+		}
+	}
+	return failedCastErrValue[E](o, err)
+}
+
+// parseUint parses s as an unsigned integer of the given base and bit size with strconv.ParseUint.
+// A string holding a valid negative integer is reported as ErrNegative instead of a syntax error.
+func parseUint(s string, base int, bits int) (uint64, error) {
+	u, err := strconv.ParseUint(s, base, bits)
+	if err != nil && strings.HasPrefix(s, "-") {
+		if i, ierr := strconv.ParseInt(s, base, 64); ierr == nil && i < 0 {
+			return 0, ErrNegative
+		}
+	}
+	return u, err
+}
@@ -3,7 +3,12 @@
 package gonv
 
 import (
+	"math"
+	"math/big"
 	"reflect"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
 )
 
 // trimZeroDecimal removes trailing zeros and decimal points from a numeric string.
@@ -51,3 +56,74 @@ func indirectValue(v reflect.Value) reflect.Value {
 	}
 	return v
 }
+
+// bitSize returns the size of the numeric type E in bits.
+//
+// Example:
+//
+//	bitSize[int8]()    // returns 8
+//	bitSize[float32]() // returns 32
+func bitSize[E constraints.Integer | constraints.Float]() int {
+	var zero E
+	return int(unsafe.Sizeof(zero)) * 8
+}
+
+// minSigned returns the smallest value of the signed integer type E as an int64.
+func minSigned[E constraints.Signed]() int64 {
+	return math.MinInt64 >> (64 - bitSize[E]())
+}
+
+// maxSigned returns the largest value of the signed integer type E as an int64.
+func maxSigned[E constraints.Signed]() int64 {
+	return math.MaxInt64 >> (64 - bitSize[E]())
+}
+
+// maxUnsigned returns the largest value of the unsigned integer type E as a uint64.
+func maxUnsigned[E constraints.Unsigned]() uint64 {
+	return math.MaxUint64 >> (64 - bitSize[E]())
+}
+
+// maxFloat returns the largest finite value of the floating-point type E as a float64.
+func maxFloat[E constraints.Float]() float64 {
+	if bitSize[E]() == 32 {
+		return math.MaxFloat32
+	}
+	return math.MaxFloat64
+}
+
+// isFinite reports whether v is neither NaN nor an infinity.
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// wrapFloat returns the low 64 bits of the finite integral value v in two's complement,
+// the bits an integer conversion keeps when it wraps around.
+// Integral float64 values beyond the range of int64 are multiples of 2^11 or more,
+// so their low bits are computed exactly, such as 4096 for 2^64+4096.
+func wrapFloat(v float64) int64 {
+	if v >= -math.Ldexp(1, 63) && v < math.Ldexp(1, 63) {
+		return int64(v)
+	}
+	// The remainder is exact and has the sign of v
+	m := math.Mod(v, math.Ldexp(1, 64))
+	if m < 0 {
+		return int64(-uint64(-m))
+	}
+	return int64(uint64(m))
+}
+
+// wrapString returns the low 64 bits in two's complement of the integer s in the given base,
+// the bits an integer conversion keeps when it wraps around, for integers beyond the range of 64 bits.
+// It reports false if s is not an integer.
+//
+// Example:
+//
+//	wrapString("18446744073709551617", 10)  // returns 1, true
+//	wrapString("-18446744073709551617", 10) // returns 18446744073709551615, true
+func wrapString(s string, base int) (uint64, bool) {
+	b, ok := new(big.Int).SetString(s, base)
+	if !ok {
+		return 0, false
+	}
+	return b.And(b, new(big.Int).SetUint64(math.MaxUint64)).Uint64(), true
+}